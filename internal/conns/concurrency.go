@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+const (
+	concurrencyLimiterMiddlewareID = "TF_AWS_ConcurrencyLimiter"
+)
+
+// newConcurrencySemaphores returns a semaphore for each service package with a concurrent request limit.
+func newConcurrencySemaphores(limits map[string]int) map[string]tfsync.Semaphore {
+	semaphores := make(map[string]tfsync.Semaphore, len(limits))
+
+	for servicePackageName, limit := range limits {
+		semaphores[servicePackageName] = tfsync.NewSemaphore(limit)
+	}
+
+	return semaphores
+}
+
+// withConcurrencyLimiter returns a copy of the specified AWS SDK for Go v2 configuration
+// whose API operations wait for capacity in the semaphore before being sent.
+// All API clients for a service package, across all Regions, share the same semaphore.
+func withConcurrencyLimiter(cfg *aws.Config, servicePackageName string, semaphore tfsync.Semaphore) *aws.Config {
+	v := cfg.Copy()
+	v.APIOptions = append(v.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(concurrencyLimiterMiddleware(servicePackageName, semaphore), middleware.Before)
+	})
+
+	return &v
+}
+
+// concurrencyLimiterMiddleware holds capacity in the semaphore for the duration of an API operation, including retries.
+func concurrencyLimiterMiddleware(servicePackageName string, semaphore tfsync.Semaphore) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(concurrencyLimiterMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if len(semaphore) == cap(semaphore) {
+			tflog.Debug(ctx, "Waiting for concurrent request capacity", map[string]any{
+				"tf_aws.service_package":              servicePackageName,
+				logging.KeyMaxConcurrentRequestsLimit: cap(semaphore),
+			})
+		}
+
+		if err := semaphore.Acquire(ctx); err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+		defer semaphore.Release()
+
+		return next.HandleInitialize(ctx, in)
+	})
+}
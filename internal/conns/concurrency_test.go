@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+func TestWithConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		limit    = 2
+		requests = 10
+	)
+
+	ctx := t.Context()
+	original := aws.Config{}
+	cfg := withConcurrencyLimiter(&original, "iam", tfsync.NewSemaphore(limit))
+
+	if got, want := len(original.APIOptions), 0; got != want {
+		t.Errorf("original APIOptions length = %d, want %d", got, want)
+	}
+	if got, want := len(cfg.APIOptions), 1; got != want {
+		t.Fatalf("APIOptions length = %d, want %d", got, want)
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	handler := middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			if m := maxInFlight.Load(); n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		return nil, middleware.Metadata{}, nil
+	})
+
+	var wg sync.WaitGroup
+	for range requests {
+		h := testConcurrencyLimiterStack(t, cfg, handler)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := h.Handle(ctx, nil); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got < 1 || got > limit {
+		t.Errorf("maximum concurrent requests = %d, want between 1 and %d", got, limit)
+	}
+}
+
+func TestWithConcurrencyLimiter_contextDone(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore(1)
+	semaphore.Wait() // Exhaust capacity.
+	cfg := withConcurrencyLimiter(&aws.Config{}, "iam", semaphore)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	handler := middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+		t.Error("handler called without capacity")
+		return nil, middleware.Metadata{}, nil
+	})
+
+	_, _, err := testConcurrencyLimiterStack(t, cfg, handler).Handle(ctx, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func testConcurrencyLimiterStack(t *testing.T, cfg *aws.Config, handler middleware.Handler) middleware.Handler {
+	t.Helper()
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	for _, fn := range cfg.APIOptions {
+		if err := fn(stack); err != nil {
+			t.Fatalf("applying API option: %s", err)
+		}
+	}
+
+	return middleware.DecorateHandler(handler, stack)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -27,6 +28,7 @@ import (
 )
 
 type Config struct {
+	APIOptions                     []func(*middleware.Stack) error // Additional AWS SDK for Go v2 API options applied to all API clients.
 	AccessKey                      string
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
@@ -42,6 +44,8 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxConcurrentRequests          map[string]int     // Service package name -> limit.
+	MaxRequestsPerSecond           map[string]float64 // Service package name, or service package name and operation name ("route53:ChangeResourceRecordSets") -> budget.
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
@@ -194,7 +198,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	switch {
+	case c.TagPolicyConfig != nil && c.TagPolicyConfig.PolicyFile != "":
+		tflog.Debug(ctx, "Reading tag policy file", map[string]any{
+			"tf_aws.tag_policy_file": c.TagPolicyConfig.PolicyFile,
+		})
+		reqTags, tagRules, err := tagpolicy.ReadPolicyFile(ctx, c.TagPolicyConfig.PolicyFile)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Reading Tag Policy File",
+				fmt.Sprintf("Failed to read the tag policy file %q.\n\nOriginal error: %s", c.TagPolicyConfig.PolicyFile, err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.RequiredTags = reqTags
+		c.TagPolicyConfig.TagRules = tagRules
+	case c.TagPolicyConfig != nil:
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -206,6 +224,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		tagRules, err := tagpolicy.GetTagRules(ctx, cfg)
+		switch {
+		case tagpolicy.IsAccessDenied(err):
+			// Required tags can still be validated without the effective tag policy
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Tag Policy",
+				`Tag key capitalization and values will not be validated. The calling principal does not `+
+					`have the "organizations:DescribeEffectivePolicy" IAM permission to retrieve the effective organizations tag policy.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+			tagRules = nil
+		case err != nil:
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Retrieving Tag Policy",
+				`Failed to retrieve the effective organizations tag policy. Ensure the calling principal `+
+					`has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.TagRules = tagRules
 	}
 
 	client.accountID = accountID
@@ -214,11 +252,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = newRateLimiters(c.MaxRequestsPerSecond)
+	client.semaphores = newConcurrencySemaphores(c.MaxConcurrentRequests)
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
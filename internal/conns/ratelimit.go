@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+const (
+	rateLimiterMiddlewareID = "TF_AWS_RateLimiter"
+	// retryMiddlewareID is the ID of the AWS SDK for Go v2 retry middleware.
+	retryMiddlewareID = "Retry"
+)
+
+// rateLimiter applies client-side request-per-second budgets to the API operations of a service package.
+type rateLimiter struct {
+	service    *tokenBucket            // Budget shared by all of the service's operations.
+	operations map[string]*tokenBucket // Operation name -> budget.
+}
+
+// newRateLimiters returns a rate limiter for each service package with a request-per-second budget.
+// Budgets are keyed by service package name ("route53") or by service package name and operation name
+// ("route53:ChangeResourceRecordSets").
+func newRateLimiters(budgets map[string]float64) map[string]*rateLimiter {
+	rateLimiters := make(map[string]*rateLimiter)
+
+	for k, rps := range budgets {
+		servicePackageName, operationName, _ := strings.Cut(k, ":")
+
+		limiter, ok := rateLimiters[servicePackageName]
+		if !ok {
+			limiter = &rateLimiter{
+				operations: make(map[string]*tokenBucket),
+			}
+			rateLimiters[servicePackageName] = limiter
+		}
+
+		bucket := newTokenBucket(k, rps)
+		if operationName == "" {
+			limiter.service = bucket
+		} else {
+			limiter.operations[operationName] = bucket
+		}
+	}
+
+	return rateLimiters
+}
+
+// buckets returns the budgets that apply to the specified operation, most specific first.
+func (l *rateLimiter) buckets(operationName string) []*tokenBucket {
+	var buckets []*tokenBucket
+
+	if v, ok := l.operations[operationName]; ok {
+		buckets = append(buckets, v)
+	}
+	if l.service != nil {
+		buckets = append(buckets, l.service)
+	}
+
+	return buckets
+}
+
+// withRateLimiter returns a copy of the specified AWS SDK for Go v2 configuration
+// whose API requests wait for the service's request-per-second budgets before being sent.
+// All API clients for a service package, across all Regions, share the same budgets.
+func withRateLimiter(cfg *aws.Config, limiter *rateLimiter) *aws.Config {
+	v := cfg.Copy()
+	v.APIOptions = append(v.APIOptions, func(stack *middleware.Stack) error {
+		m := rateLimiterMiddleware(limiter)
+		// Run after the retry middleware so that every attempt, including retries, is subject to the budgets.
+		if err := stack.Finalize.Insert(m, retryMiddlewareID, middleware.After); err != nil {
+			return stack.Finalize.Add(m, middleware.After)
+		}
+		return nil
+	})
+
+	return &v
+}
+
+func rateLimiterMiddleware(limiter *rateLimiter) middleware.FinalizeMiddleware {
+	throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	return middleware.FinalizeMiddlewareFunc(rateLimiterMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		buckets := limiter.buckets(awsmiddleware.GetOperationName(ctx))
+
+		for _, bucket := range buckets {
+			delay, err := bucket.wait(ctx)
+			if delay > 0 {
+				logging.RateLimitWait(ctx, bucket.name, delay, bucket.waits.Add(1))
+			}
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+		}
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		if err != nil && throttles.IsErrorThrottle(err) == aws.TrueTernary {
+			for _, bucket := range buckets {
+				logging.RateLimitThrottle(ctx, bucket.name, bucket.throttles.Add(1))
+			}
+		}
+
+		return out, metadata, err
+	})
+}
+
+// tokenBucket is a token bucket rate limiter.
+// The bucket holds at most one second's worth of tokens.
+type tokenBucket struct {
+	name      string
+	rate      float64 // Tokens per second.
+	capacity  float64
+	throttles atomic.Int64
+	waits     atomic.Int64
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(name string, rate float64) *tokenBucket {
+	capacity := math.Max(1, rate)
+
+	return &tokenBucket{
+		name:     name,
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+		now:      time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (b *tokenBucket) cancel() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = math.Min(b.capacity, b.tokens+1)
+}
+
+// wait takes a token from the bucket, waiting until one is available or the context is done.
+// The time spent waiting is returned.
+func (b *tokenBucket) wait(ctx context.Context) (time.Duration, error) {
+	delay := b.reserve()
+	if delay == 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		b.cancel()
+		return delay, ctx.Err()
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	bucket := newTokenBucket("test", 2)
+	bucket.last = now
+	bucket.now = func() time.Time { return now }
+
+	// The bucket starts full.
+	for i := range 2 {
+		if got := bucket.reserve(); got != 0 {
+			t.Errorf("reserve %d: delay = %s, want 0", i, got)
+		}
+	}
+
+	// Tokens are refilled at 2 per second.
+	if got, want := bucket.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+	if got, want := bucket.reserve(), 1*time.Second; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+
+	now = now.Add(5 * time.Second)
+
+	// The bucket never holds more than one second's worth of tokens.
+	for i := range 2 {
+		if got := bucket.reserve(); got != 0 {
+			t.Errorf("reserve %d: delay = %s, want 0", i, got)
+		}
+	}
+	if got, want := bucket.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+}
+
+func TestTokenBucketReserve_lessThanOnePerSecond(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	bucket := newTokenBucket("test", 0.5)
+	bucket.last = now
+	bucket.now = func() time.Time { return now }
+
+	if got := bucket.reserve(); got != 0 {
+		t.Errorf("delay = %s, want 0", got)
+	}
+	if got, want := bucket.reserve(), 2*time.Second; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+}
+
+func TestTokenBucketWait_contextDone(t *testing.T) {
+	t.Parallel()
+
+	bucket := newTokenBucket("test", 1)
+	bucket.reserve() // Empty the bucket.
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if _, err := bucket.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestNewRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	rateLimiters := newRateLimiters(map[string]float64{
+		"route53":                          10,
+		"route53:ChangeResourceRecordSets": 5,
+		"iam:CreateRole":                   2,
+	})
+
+	if got, want := len(rateLimiters), 2; got != want {
+		t.Fatalf("rate limiters = %d, want %d", got, want)
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		operationName      string
+		expected           []string
+	}{
+		"service and operation": {
+			servicePackageName: "route53",
+			operationName:      "ChangeResourceRecordSets",
+			expected:           []string{"route53:ChangeResourceRecordSets", "route53"},
+		},
+		"service only": {
+			servicePackageName: "route53",
+			operationName:      "ListHostedZones",
+			expected:           []string{"route53"},
+		},
+		"operation only": {
+			servicePackageName: "iam",
+			operationName:      "CreateRole",
+			expected:           []string{"iam:CreateRole"},
+		},
+		"no budget": {
+			servicePackageName: "iam",
+			operationName:      "GetRole",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, bucket := range rateLimiters[testCase.servicePackageName].buckets(testCase.operationName) {
+				got = append(got, bucket.name)
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("buckets = %v, want %v", got, testCase.expected)
+			}
+			for i := range got {
+				if got[i] != testCase.expected[i] {
+					t.Errorf("buckets = %v, want %v", got, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	limiter := newRateLimiters(map[string]float64{"iam:CreateRole": 1})["iam"]
+	original := aws.Config{}
+	cfg := withRateLimiter(&original, limiter)
+
+	if got, want := len(original.APIOptions), 0; got != want {
+		t.Errorf("original APIOptions length = %d, want %d", got, want)
+	}
+
+	throttle := &smithy.GenericAPIError{
+		Code:    "Throttling",
+		Message: "Rate exceeded",
+	}
+	handler := middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, throttle
+	})
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: "CreateRole"}, middleware.Before); err != nil {
+		t.Fatalf("adding service metadata: %s", err)
+	}
+	for _, fn := range cfg.APIOptions {
+		if err := fn(stack); err != nil {
+			t.Fatalf("applying API option: %s", err)
+		}
+	}
+	if _, ok := stack.Finalize.Get(rateLimiterMiddlewareID); !ok {
+		t.Fatalf("middleware %s not found", rateLimiterMiddlewareID)
+	}
+
+	h := middleware.DecorateHandler(handler, stack)
+
+	start := time.Now()
+	for range 2 {
+		if _, _, err := h.Handle(ctx, nil); !errors.Is(err, throttle) {
+			t.Errorf("error = %v, want %v", err, throttle)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("elapsed = %s, want at least 1s", elapsed)
+	}
+
+	bucket := limiter.operations["CreateRole"]
+	if got, want := bucket.waits.Load(), int64(1); got != want {
+		t.Errorf("waits = %d, want %d", got, want)
+	}
+	if got, want := bucket.throttles.Load(), int64(2); got != want {
+		t.Errorf("throttles = %d, want %d", got, want)
+	}
+}
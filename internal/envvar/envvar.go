@@ -96,6 +96,27 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used with resource sweepers
+const (
+	// Set to true to report the resources that would be swept instead of deleting them.
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Only sweep resources created at least this long ago, for example "24h".
+	// Resources whose creation time is unknown are not swept.
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// The maximum number of resources a sweeper deletes concurrently.
+	// Defaults to 10.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Path of a file to write a JSON report of swept and skipped resources to.
+	SweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// Only sweep resources with these tags, for example "CreatedBy=acctest,Team".
+	// A tag with no value matches any value. Resources whose tags are unknown are not swept.
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -8,12 +8,13 @@ import (
 	"strconv"
 	"sync"
 
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	testing "github.com/mitchellh/go-testing-interface"
 )
 
 // Semaphore can be used to limit concurrent executions.
 // This can be used to work with resources with low quotas.
-type Semaphore chan struct{}
+type Semaphore = tfsync.Semaphore
 
 var semaphoreKV = &struct {
 	lock  sync.Locker
@@ -38,30 +39,13 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 			}
 		}
 
-		semaphore = make(Semaphore, limit)
+		semaphore = tfsync.NewSemaphore(limit)
 		semaphoreKV.store[key] = semaphore
 	}
 
 	return semaphore
 }
 
-// Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
-func (s Semaphore) Wait() {
-	s <- struct{}{}
-}
-
-// Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
-func (s Semaphore) Notify() {
-	// Make the Notify non-blocking. This can happen if a Wait was never issued
-	select {
-	case <-s:
-	default:
-		// log.Println("[WARN] Notifying semaphore without Wait")
-	}
-}
-
 // TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func TestAccPreCheckSyncronize(t testing.T, semaphore Semaphore, resource string) {
@@ -6,6 +6,7 @@ package flex
 import (
 	"context"
 	"fmt"
+	"iter"
 	"reflect"
 	"strings"
 
@@ -75,9 +76,18 @@ type fuzzyFieldFinder struct {
 }
 
 func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) { //nolint:unparam
+	if fieldNameTo, ok := fff.findFieldName(fieldNameFrom, reflectFieldNames{typeFrom}, reflectFieldNames{typeTo}, flexer.getOptions()); ok {
+		return typeTo.FieldByName(fieldNameTo)
+	}
+
+	// no finds, fuzzy or otherwise - return zero value
+	return reflect.StructField{}, false
+}
+
+func (fff *fuzzyFieldFinder) findFieldName(fieldNameFrom string, from, to FieldNames, opts AutoFlexOptions) (string, bool) {
 	// first precedence is exact match (case sensitive)
-	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
-		return fieldTo, true
+	if to.HasField(fieldNameFrom) {
+		return fieldNameFrom, true
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -87,30 +97,27 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 	// to make sure fuzzy matches are not in "from".
 
 	// second precedence is exact match (case insensitive)
-	opts := flexer.getOptions()
-	for field := range tfreflect.ExportedStructFields(typeTo) {
-		fieldNameTo := field.Name
+	for fieldNameTo := range to.ExportedFieldNames() {
 		if opts.isIgnoredField(fieldNameTo) {
 			continue
 		}
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return fieldTo, true
+		if to.HasField(fieldNameTo) && strings.EqualFold(fieldNameFrom, fieldNameTo) && !from.HasField(fieldNameTo) {
+			return fieldNameTo, true
 		}
 	}
 
 	// third precedence is singular/plural
 	fieldNameTo := plural.Plural(fieldNameFrom)
-	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
-			return fieldTo, true
+	if plural.IsSingular(fieldNameFrom) && !from.HasField(fieldNameTo) {
+		if to.HasField(fieldNameTo) {
+			return fieldNameTo, true
 		}
 	}
 
 	fieldNameTo = plural.Singular(fieldNameFrom)
-	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
-		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
-			return fieldTo, true
+	if plural.IsPlural(fieldNameFrom) && !from.HasField(fieldNameTo) {
+		if to.HasField(fieldNameTo) {
+			return fieldNameTo, true
 		}
 	}
 
@@ -121,14 +128,14 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 			// so it will only recurse once
 			fff.prefixRecursionDepth++
 			if trimmed, ok := strings.CutPrefix(fieldNameFrom, v); ok {
-				if fieldTo, ok := fff.findField(ctx, trimmed, typeFrom, typeTo, flexer); ok {
+				if fieldNameTo, ok := fff.findFieldName(trimmed, from, to, opts); ok {
 					fff.prefixRecursionDepth--
-					return fieldTo, true
+					return fieldNameTo, true
 				}
 			} else {
-				if fieldTo, ok := fff.findField(ctx, v+fieldNameFrom, typeFrom, typeTo, flexer); ok {
+				if fieldNameTo, ok := fff.findFieldName(v+fieldNameFrom, from, to, opts); ok {
 					fff.prefixRecursionDepth--
-					return fieldTo, true
+					return fieldNameTo, true
 				}
 			}
 			// no match via prefix mutation; fall through to suffix handling on the original name
@@ -142,25 +149,71 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 			// so it will only recurse once
 			fff.suffixRecursionDepth++
 			if strings.HasSuffix(fieldNameFrom, v) {
-				fieldTo, ok := fff.findField(ctx, strings.TrimSuffix(fieldNameFrom, v), typeFrom, typeTo, flexer)
+				fieldNameTo, ok := fff.findFieldName(strings.TrimSuffix(fieldNameFrom, v), from, to, opts)
 				fff.suffixRecursionDepth--
-				return fieldTo, ok
+				return fieldNameTo, ok
 			}
-			fieldTo, ok := fff.findField(ctx, fieldNameFrom+v, typeFrom, typeTo, flexer)
+			fieldNameTo, ok := fff.findFieldName(fieldNameFrom+v, from, to, opts)
 			fff.suffixRecursionDepth--
-			return fieldTo, ok
+			return fieldNameTo, ok
 		}
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return reflect.StructField{}, false
+	// no finds, fuzzy or otherwise
+	return "", false
+}
+
+// FieldNames is the set of a struct type's field names, as used by AutoFlex field matching.
+type FieldNames interface {
+	// HasField returns whether the struct type has a field, including a promoted field, with the specified name.
+	HasField(name string) bool
+	// ExportedFieldNames returns the names of the struct type's exported fields, including those of embedded structs.
+	ExportedFieldNames() iter.Seq[string]
 }
 
-func fieldExistsInStruct(field string, structType reflect.Type) bool {
-	_, ok := structType.FieldByName(field)
+// reflectFieldNames is the set of field names of a struct type known via reflection.
+type reflectFieldNames struct {
+	typ reflect.Type
+}
+
+func (n reflectFieldNames) HasField(name string) bool {
+	_, ok := n.typ.FieldByName(name)
 	return ok
 }
 
+func (n reflectFieldNames) ExportedFieldNames() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for field := range tfreflect.ExportedStructFields(n.typ) {
+			if !yield(field.Name) {
+				return
+			}
+		}
+	}
+}
+
+// FieldMatcher matches field names between Terraform and AWS data structures in the same way as Expand and Flatten.
+// It allows AutoFlex field matching to be checked without values, for example by static analysis tools.
+type FieldMatcher struct {
+	opts AutoFlexOptions
+}
+
+// NewFieldMatcher returns a FieldMatcher with the same defaults as Expand and Flatten, overridden via functional options.
+func NewFieldMatcher(optFns ...AutoFlexOptionsFunc) *FieldMatcher {
+	return &FieldMatcher{
+		opts: newAutoExpander(optFns).getOptions(),
+	}
+}
+
+// IsIgnoredField returns whether the named field is neither read from nor written to.
+func (m *FieldMatcher) IsIgnoredField(fieldName string) bool {
+	return m.opts.isIgnoredField(fieldName)
+}
+
+// MatchField returns the name of the field in `to` that the field named `fieldNameFrom` in `from` is converted to.
+func (m *FieldMatcher) MatchField(fieldNameFrom string, from, to FieldNames) (string, bool) {
+	return (&fuzzyFieldFinder{}).findFieldName(fieldNameFrom, from, to, m.opts)
+}
+
 func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
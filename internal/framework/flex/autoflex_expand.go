@@ -6,7 +6,6 @@ package flex
 import (
 	"context"
 	"fmt"
-	"iter"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -1192,11 +1191,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
-	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
+	for mapping := range expandFieldPlan(ctx, typeFrom, typeTo, flexer).sourceFields(ctx) {
+		fromField := mapping.from
 		fromFieldName := fromField.Name
 		_, fromFieldOpts := autoflexTags(fromField)
 
-		toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		toField, ok := mapping.to, mapping.matched
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -1234,39 +1234,6 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	return diags
 }
 
-func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
-	return func(yield func(reflect.StructField) bool) {
-		for field := range tfreflect.ExportedStructFields(typ) {
-			fieldName := field.Name
-			if opts.isIgnoredField(fieldName) {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			fromNameOverride, _ := autoflexTags(field)
-			if fromNameOverride == "-" {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			if fieldName == mapBlockKeyFieldName {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
-					logAttrKeySourceFieldname: mapBlockKeyFieldName,
-				})
-				continue
-			}
-
-			if !yield(field) {
-				return
-			}
-		}
-	}
-}
-
 // mapBlockKey takes a struct and extracts the value of the `key`
 func mapBlockKey(ctx context.Context, from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
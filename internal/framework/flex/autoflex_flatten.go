@@ -6,7 +6,6 @@ package flex
 import (
 	"context"
 	"fmt"
-	"iter"
 	"reflect"
 	"strings"
 	"time"
@@ -1764,10 +1763,11 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		}
 	}
 
-	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
+	for mapping := range flattenFieldPlan(ctx, typeFrom, typeTo, flexer).sourceFields(ctx) {
+		fromField := mapping.from
 		fromFieldName := fromField.Name
 
-		toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		toField, ok := mapping.to, mapping.matched
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -1850,24 +1850,6 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 	return diags
 }
 
-func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
-	return func(yield func(reflect.StructField) bool) {
-		for field := range tfreflect.ExportedStructFields(typ) {
-			fieldName := field.Name
-			if opts.isIgnoredField(fieldName) {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			if !yield(field) {
-				return
-			}
-		}
-	}
-}
-
 // setMapBlockKey takes a struct and assigns the value of the `key`
 func setMapBlockKey(ctx context.Context, to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
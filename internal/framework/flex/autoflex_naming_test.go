@@ -7,7 +7,9 @@ package flex
 
 import (
 	"context"
+	"iter"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -342,6 +344,86 @@ func TestFindFieldFuzzy_Combinations(t *testing.T) {
 	}
 }
 
+// staticFieldNames is a FieldNames implementation that doesn't use reflection.
+type staticFieldNames []string
+
+func (n staticFieldNames) HasField(name string) bool {
+	return slices.Contains(n, name)
+}
+
+func (n staticFieldNames) ExportedFieldNames() iter.Seq[string] {
+	return slices.Values(n)
+}
+
+func TestFieldMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options       []AutoFlexOptionsFunc
+		from          staticFieldNames
+		to            staticFieldNames
+		fieldNameFrom string
+		expectedName  string
+		expectedFound bool
+	}{
+		"exact match": {
+			from:          staticFieldNames{"Name"},
+			to:            staticFieldNames{"Name"},
+			fieldNameFrom: "Name",
+			expectedName:  "Name",
+			expectedFound: true,
+		},
+		"case-insensitive match": {
+			from:          staticFieldNames{"ARN"},
+			to:            staticFieldNames{"Arn"},
+			fieldNameFrom: "ARN",
+			expectedName:  "Arn",
+			expectedFound: true,
+		},
+		"plural match": {
+			from:          staticFieldNames{"SecurityGroupIDs"},
+			to:            staticFieldNames{"SecurityGroupIds"},
+			fieldNameFrom: "SecurityGroupIDs",
+			expectedName:  "SecurityGroupIds",
+			expectedFound: true,
+		},
+		"singular not matched when source has plural": {
+			from:          staticFieldNames{"Value", "Values"},
+			to:            staticFieldNames{"Value"},
+			fieldNameFrom: "Values",
+			expectedFound: false,
+		},
+		"prefix match": {
+			options:       []AutoFlexOptionsFunc{WithFieldNamePrefix("Intent")},
+			from:          staticFieldNames{"Name"},
+			to:            staticFieldNames{"IntentName"},
+			fieldNameFrom: "Name",
+			expectedName:  "IntentName",
+			expectedFound: true,
+		},
+		"renamed field": {
+			from:          staticFieldNames{"Colour"},
+			to:            staticFieldNames{"Color"},
+			fieldNameFrom: "Colour",
+			expectedFound: false,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			name, found := NewFieldMatcher(testCase.options...).MatchField(testCase.fieldNameFrom, testCase.from, testCase.to)
+			if found != testCase.expectedFound {
+				t.Fatalf("expected found %t, got %t", testCase.expectedFound, found)
+			}
+			if name != testCase.expectedName {
+				t.Fatalf("expected field name %q, got %q", testCase.expectedName, name)
+			}
+		})
+	}
+}
+
 func TestExpandFieldNamePrefix(t *testing.T) {
 	t.Parallel()
 
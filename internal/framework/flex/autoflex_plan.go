@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// fieldPlanDirection is the direction of the conversion that a field mapping plan is for.
+type fieldPlanDirection int
+
+const (
+	fieldPlanExpand fieldPlanDirection = iota
+	fieldPlanFlatten
+)
+
+// fieldPlanKey identifies a field mapping plan.
+// Only the options that affect field matching are part of the key.
+type fieldPlanKey struct {
+	direction         fieldPlanDirection
+	typeFrom          reflect.Type
+	typeTo            reflect.Type
+	fieldNamePrefix   string
+	fieldNameSuffix   string
+	ignoredFieldNames string
+}
+
+// fieldPlan is the compiled mapping between the exported fields of a source struct type
+// and the fields of a target struct type.
+type fieldPlan struct {
+	fields []fieldMapping // In source field order.
+}
+
+// fieldMapping is the mapping of one source field.
+type fieldMapping struct {
+	from reflect.StructField
+	// skipMessage is logged if the source field is skipped, otherwise it is empty.
+	skipMessage string
+	// to is the corresponding target field, valid only if matched is true.
+	to      reflect.StructField
+	matched bool
+}
+
+// fieldPlans caches compiled field mapping plans.
+// Struct types and options are fixed at compile time, so plans never need to be invalidated.
+var fieldPlans sync.Map // fieldPlanKey -> *fieldPlan
+
+// expandFieldPlan returns the field mapping plan for expanding from `typeFrom` to `typeTo`.
+func expandFieldPlan(ctx context.Context, typeFrom, typeTo reflect.Type, flexer autoFlexer) *fieldPlan {
+	return cachedFieldPlan(ctx, fieldPlanExpand, typeFrom, typeTo, flexer)
+}
+
+// flattenFieldPlan returns the field mapping plan for flattening from `typeFrom` to `typeTo`.
+func flattenFieldPlan(ctx context.Context, typeFrom, typeTo reflect.Type, flexer autoFlexer) *fieldPlan {
+	return cachedFieldPlan(ctx, fieldPlanFlatten, typeFrom, typeTo, flexer)
+}
+
+func cachedFieldPlan(ctx context.Context, direction fieldPlanDirection, typeFrom, typeTo reflect.Type, flexer autoFlexer) *fieldPlan {
+	opts := flexer.getOptions()
+	key := fieldPlanKey{
+		direction:         direction,
+		typeFrom:          typeFrom,
+		typeTo:            typeTo,
+		fieldNamePrefix:   opts.fieldNamePrefix,
+		fieldNameSuffix:   opts.fieldNameSuffix,
+		ignoredFieldNames: strings.Join(opts.ignoredFieldNames, "\x00"),
+	}
+
+	if v, ok := fieldPlans.Load(key); ok {
+		return v.(*fieldPlan)
+	}
+
+	// Concurrent callers may compile the same plan; the first one stored wins.
+	v, _ := fieldPlans.LoadOrStore(key, compileFieldPlan(ctx, direction, typeFrom, typeTo, flexer))
+
+	return v.(*fieldPlan)
+}
+
+func compileFieldPlan(ctx context.Context, direction fieldPlanDirection, typeFrom, typeTo reflect.Type, flexer autoFlexer) *fieldPlan {
+	opts := flexer.getOptions()
+	plan := &fieldPlan{}
+
+	for field := range tfreflect.ExportedStructFields(typeFrom) {
+		mapping := fieldMapping{
+			from: field,
+		}
+
+		if skipMessage := sourceFieldSkipMessage(direction, field, opts); skipMessage != "" {
+			mapping.skipMessage = skipMessage
+		} else {
+			mapping.to, mapping.matched = (&fuzzyFieldFinder{}).findField(ctx, field.Name, typeFrom, typeTo, flexer)
+		}
+
+		plan.fields = append(plan.fields, mapping)
+	}
+
+	return plan
+}
+
+// sourceFieldSkipMessage returns the message logged when a source field isn't converted, or "" if it is.
+func sourceFieldSkipMessage(direction fieldPlanDirection, field reflect.StructField, opts AutoFlexOptions) string {
+	if opts.isIgnoredField(field.Name) {
+		return "Skipping ignored source field"
+	}
+
+	if direction == fieldPlanExpand {
+		if fromNameOverride, _ := autoflexTags(field); fromNameOverride == "-" {
+			return "Skipping ignored source field"
+		}
+
+		if field.Name == mapBlockKeyFieldName {
+			return "Skipping map block key"
+		}
+	}
+
+	return ""
+}
+
+// sourceFields returns the mappings of the source fields that are converted, logging those that are skipped.
+func (plan *fieldPlan) sourceFields(ctx context.Context) iter.Seq[fieldMapping] {
+	return func(yield func(fieldMapping) bool) {
+		for _, mapping := range plan.fields {
+			if mapping.skipMessage != "" {
+				tflog.SubsystemTrace(ctx, subsystemName, mapping.skipMessage, map[string]any{
+					logAttrKeySourceFieldname: mapping.from.Name,
+				})
+				continue
+			}
+
+			if !yield(mapping) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldPlanCache(t *testing.T) {
+	t.Parallel()
+
+	type source struct {
+		Name        string
+		Tags        string
+		MapBlockKey string
+		Ignored     string `autoflex:"-"`
+		Unmatched   string
+	}
+	type target struct {
+		ClusterName string
+		Tags        string
+	}
+
+	ctx := t.Context()
+	typeFrom, typeTo := reflect.TypeFor[source](), reflect.TypeFor[target]()
+
+	plan := expandFieldPlan(ctx, typeFrom, typeTo, newAutoExpander(nil))
+	if got := expandFieldPlan(ctx, typeFrom, typeTo, newAutoExpander(nil)); got != plan {
+		t.Errorf("expected cached plan to be reused")
+	}
+	if got := flattenFieldPlan(ctx, typeFrom, typeTo, newAutoFlattener(nil)); got == plan {
+		t.Errorf("expected flatten plan to differ from expand plan")
+	}
+
+	prefixed := expandFieldPlan(ctx, typeFrom, typeTo, newAutoExpander([]AutoFlexOptionsFunc{WithFieldNamePrefix("Cluster")}))
+	if prefixed == plan {
+		t.Fatalf("expected plan with different options to differ")
+	}
+
+	testCases := map[string]struct {
+		plan     *fieldPlan
+		expected map[string]string // Source field name -> target field name, "" if unmatched.
+	}{
+		"no options": {
+			plan: plan,
+			expected: map[string]string{
+				"Name":      "",
+				"Unmatched": "",
+			},
+		},
+		"field name prefix": {
+			plan: prefixed,
+			expected: map[string]string{
+				"Name":      "ClusterName",
+				"Unmatched": "",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make(map[string]string)
+			for mapping := range testCase.plan.sourceFields(ctx) {
+				got[mapping.from.Name] = ""
+				if mapping.matched {
+					got[mapping.from.Name] = mapping.to.Name
+				}
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
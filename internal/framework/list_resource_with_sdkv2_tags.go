@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -57,3 +58,13 @@ func (r *ListResourceWithSDKv2Tags) SetTags(ctx context.Context, client *conns.A
 
 	return nil
 }
+
+// MatchesTags returns whether the resource's tags, as set by SetTags, include all of the specified tags.
+// An empty set of tags matches every resource.
+func (r *ListResourceWithSDKv2Tags) MatchesTags(ctx context.Context, d *schema.ResourceData, tags tftags.KeyValueTags) bool {
+	if len(tags) == 0 {
+		return true
+	}
+
+	return tftags.New(ctx, d.Get(names.AttrTagsAll)).ContainsAll(tags)
+}
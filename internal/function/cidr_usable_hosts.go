@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// AWS reserves the first four IP addresses and the last IP address in each subnet:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// subnetReservedAddresses is the number of IP addresses AWS reserves in every subnet
+	subnetReservedAddresses = 5
+
+	// subnetMinPrefixLength is the prefix length of the largest IPv4 subnet or VPC
+	subnetMinPrefixLength = 16
+
+	// subnetMaxPrefixLength is the prefix length of the smallest IPv4 subnet or VPC
+	subnetMaxPrefixLength = 28
+)
+
+var _ function.Function = cidrUsableHostsFunction{}
+
+func NewCIDRUsableHostsFunction() function.Function {
+	return &cidrUsableHostsFunction{}
+}
+
+type cidrUsableHostsFunction struct{}
+
+func (f cidrUsableHostsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_usable_hosts"
+}
+
+func (f cidrUsableHostsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_usable_hosts Function",
+		MarkdownDescription: "Returns the number of IP addresses available for use in an IPv4 subnet, " +
+			"excluding the five addresses that AWS reserves in every subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 subnet CIDR block",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f cidrUsableHostsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseSubnetCIDRBlock(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, usableHosts(prefix.Bits())))
+}
+
+// parseSubnetCIDRBlock parses an IPv4 CIDR block that is valid as an AWS VPC or subnet CIDR block.
+func parseSubnetCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := verify.ValidateIPv4CIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if bits := prefix.Bits(); bits < subnetMinPrefixLength || bits > subnetMaxPrefixLength {
+		return netip.Prefix{}, fmt.Errorf("%q prefix length must be between /%d and /%d", cidr, subnetMinPrefixLength, subnetMaxPrefixLength)
+	}
+
+	return prefix, nil
+}
+
+// usableHosts returns the number of usable IP addresses in an IPv4 subnet with the specified prefix length.
+func usableHosts(bits int) int64 {
+	return int64(1)<<(32-bits) - subnetReservedAddresses
+}
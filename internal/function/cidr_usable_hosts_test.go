@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRUsableHostsFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	for cidr, expected := range map[string]string{
+		"10.0.0.0/16":   "65531",
+		"10.0.1.0/24":   "251",
+		"10.0.1.240/28": "11",
+	} {
+		t.Run(cidr, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testCIDRUsableHostsFunctionConfig(cidr),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", expected),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRUsableHostsFunction_invalidCIDR(t *testing.T) {
+	t.Parallel()
+	arg := "10.0.1.1/24"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRUsableHostsFunctionConfig(arg),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv4[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func TestCIDRUsableHostsFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+	arg := "10.0.1.0/29"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRUsableHostsFunctionConfig(arg),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testCIDRUsableHostsFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_usable_hosts(%[1]q)
+}`, arg)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	// policyDocumentKeyOrder is the order in which known policy document
+	// elements are written. Version is first as required by AWS in many places.
+	policyDocumentKeyOrder = []string{"Version", "Id", "Statement"}
+
+	// policyStatementKeyOrder is the order in which known policy statement
+	// elements are written.
+	policyStatementKeyOrder = []string{"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource", "NotResource", "Condition"}
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Returns the canonical form of an IAM policy document. Policy documents " +
+			"that differ only in formatting, element order or single-element lists " +
+			"normalize to the same string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document (JSON)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizePolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizePolicy returns the canonical form of an IAM policy document.
+// The result is always semantically equivalent to the input, as determined by
+// verify.PolicyStringsEquivalent.
+func normalizePolicy(s string) (string, error) {
+	if strings.TrimSpace(s) == "" {
+		return "", nil
+	}
+
+	var document any
+	if err := unmarshalPolicy(s, &document); err != nil {
+		return "", err
+	}
+
+	m, ok := document.(map[string]any)
+	if !ok {
+		return "", errors.New("policy must be a JSON object")
+	}
+
+	if v, ok := m["Statement"]; ok {
+		var statements []any
+		switch v := v.(type) {
+		case []any:
+			statements = v
+		case map[string]any:
+			statements = []any{v}
+		default:
+			return "", errors.New("policy Statement must be a JSON object or array")
+		}
+
+		for i, v := range statements {
+			statement, ok := v.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("policy Statement[%d] must be a JSON object", i)
+			}
+			statements[i] = normalizePolicyStatement(statement)
+		}
+		m["Statement"] = statements
+	}
+
+	var buf bytes.Buffer
+	if err := writeOrderedJSON(&buf, m, policyDocumentKeyOrder); err != nil {
+		return "", err
+	}
+	result := buf.String()
+
+	if !verify.PolicyStringsEquivalent(s, result) {
+		return "", errors.New("normalized policy is not equivalent to the input policy")
+	}
+
+	return result, nil
+}
+
+func normalizePolicyStatement(statement map[string]any) orderedObject {
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = normalizePolicyValues(v)
+		}
+	}
+
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if principal, ok := statement[k].(map[string]any); ok {
+			for typ, values := range principal {
+				principal[typ] = normalizePolicyValues(values)
+			}
+		}
+	}
+
+	if condition, ok := statement["Condition"].(map[string]any); ok {
+		for _, v := range condition {
+			if block, ok := v.(map[string]any); ok {
+				for key, values := range block {
+					block[key] = normalizePolicyValues(values)
+				}
+			}
+		}
+	}
+
+	return orderedObject{m: statement, order: policyStatementKeyOrder}
+}
+
+// normalizePolicyValues sorts a list of string values.
+// A list with a single value is replaced by that value.
+func normalizePolicyValues(v any) any {
+	list, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			// Leave lists of non-string values as-is.
+			return list
+		}
+		values = append(values, s)
+	}
+
+	slices.Sort(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
+
+func unmarshalPolicy(s string, v any) error {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("policy is invalid JSON: %w", err)
+	}
+
+	if decoder.More() {
+		return errors.New("policy is invalid JSON: unexpected data after top-level value")
+	}
+
+	return nil
+}
+
+// orderedObject is a JSON object whose known keys are written in a fixed order.
+type orderedObject struct {
+	m     map[string]any
+	order []string
+}
+
+// writeOrderedJSON writes the compact JSON encoding of m to buf. Keys in order
+// are written first, followed by any remaining keys in lexical order.
+func writeOrderedJSON(buf *bytes.Buffer, m map[string]any, order []string) error {
+	keys := make([]string, 0, len(m))
+	for _, k := range order {
+		if _, ok := m[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	var rest []string
+	for k := range m {
+		if !slices.Contains(order, k) {
+			rest = append(rest, k)
+		}
+	}
+	slices.Sort(rest)
+	keys = append(keys, rest...)
+
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSONValue(buf, k); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		if err := writeJSONValue(buf, m[k]); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func writeJSONValue(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case orderedObject:
+		return writeOrderedJSON(buf, v.m, v.order)
+	case []any:
+		buf.WriteByte('[')
+		for i, v := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONValue(buf, v); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	default:
+		// Maps are encoded with sorted keys and without HTML escaping, as jsonencode() does.
+		encoder := json.NewEncoder(buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		// Remove the trailing newline added by Encode.
+		buf.Truncate(buf.Len() - 1)
+		return nil
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var vpcSubnetPlanTierAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"netmask_length": types.Int64Type,
+}
+
+var vpcSubnetPlanResultAttrTypes = map[string]attr.Type{
+	"tier":                    types.StringType,
+	"availability_zone_index": types.Int64Type,
+	"cidr_block":              types.StringType,
+	"usable_hosts":            types.Int64Type,
+}
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+type vpcSubnetPlanTier struct {
+	Name          string `tfsdk:"name"`
+	NetmaskLength int64  `tfsdk:"netmask_length"`
+}
+
+type vpcSubnetPlanSubnet struct {
+	tier      string
+	azIndex   int64
+	cidrBlock netip.Prefix
+}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Divides an IPv4 VPC CIDR block into one subnet per Availability Zone for each tier. " +
+			"Subnets are allocated in order, tier by tier, each aligned on its own size.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 VPC CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones",
+			},
+			function.ListParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Ordered list of subnet tiers, each with a unique `name` and the `netmask_length` of its subnets",
+				ElementType: types.ObjectType{
+					AttrTypes: vpcSubnetPlanTierAttrTypes,
+				},
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: vpcSubnetPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var azCount int64
+	var tiers []vpcSubnetPlanTier
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &azCount, &tiers))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseSubnetCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if azCount < 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "az_count must be at least 1"))
+		return
+	}
+
+	if err := validateSubnetPlanTiers(prefix, tiers); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	subnets, err := planSubnets(prefix, azCount, tiers)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elements := make([]attr.Value, 0, len(subnets))
+	for _, subnet := range subnets {
+		value := map[string]attr.Value{
+			"tier":                    types.StringValue(subnet.tier),
+			"availability_zone_index": types.Int64Value(subnet.azIndex),
+			"cidr_block":              types.StringValue(subnet.cidrBlock.String()),
+			"usable_hosts":            types.Int64Value(usableHosts(subnet.cidrBlock.Bits())),
+		}
+
+		element, d := types.ObjectValue(vpcSubnetPlanResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		elements = append(elements, element)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes}, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func validateSubnetPlanTiers(prefix netip.Prefix, tiers []vpcSubnetPlanTier) error {
+	if len(tiers) == 0 {
+		return errors.New("at least one tier must be specified")
+	}
+
+	names := make(map[string]struct{}, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return errors.New("tier name must not be empty")
+		}
+		if _, ok := names[tier.Name]; ok {
+			return fmt.Errorf("duplicate tier name %q", tier.Name)
+		}
+		names[tier.Name] = struct{}{}
+
+		if tier.NetmaskLength < int64(prefix.Bits()) || tier.NetmaskLength > subnetMaxPrefixLength {
+			return fmt.Errorf("tier %q netmask_length must be between %d and %d", tier.Name, prefix.Bits(), subnetMaxPrefixLength)
+		}
+	}
+
+	return nil
+}
+
+// planSubnets allocates one subnet per Availability Zone for each tier, in order.
+// Each subnet starts at the next address that is aligned on the subnet's size,
+// so subnets of differing sizes never overlap.
+func planSubnets(prefix netip.Prefix, azCount int64, tiers []vpcSubnetPlanTier) ([]vpcSubnetPlanSubnet, error) {
+	addr := prefix.Addr().As4()
+	start := uint64(addr[0])<<24 | uint64(addr[1])<<16 | uint64(addr[2])<<8 | uint64(addr[3])
+	end := start + uint64(1)<<(32-prefix.Bits())
+	next := start
+
+	var subnets []vpcSubnetPlanSubnet
+	for _, tier := range tiers {
+		size := uint64(1) << (32 - tier.NetmaskLength)
+
+		for azIndex := range azCount {
+			// Round up to the next multiple of the subnet size.
+			next = (next + size - 1) &^ (size - 1)
+			if next+size > end {
+				return nil, fmt.Errorf("%s does not have enough address space for tier %q subnet in Availability Zone index %d", prefix, tier.Name, azIndex)
+			}
+
+			subnets = append(subnets, vpcSubnetPlanSubnet{
+				tier:      tier.Name,
+				azIndex:   azIndex,
+				cidrBlock: netip.PrefixFrom(netip.AddrFrom4([4]byte{byte(next >> 24), byte(next >> 16), byte(next >> 8), byte(next)}), int(tier.NetmaskLength)),
+			})
+			next += size
+		}
+	}
+
+	return subnets, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 3, `[
+    { name = "public", netmask_length = 24 },
+    { name = "private", netmask_length = 20 },
+    { name = "database", netmask_length = 26 },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_blocks", "10.0.0.0/24,10.0.1.0/24,10.0.2.0/24,10.0.16.0/20,10.0.32.0/20,10.0.48.0/20,10.0.64.0/26,10.0.64.64/26,10.0.64.128/26"),
+					resource.TestCheckOutput("tiers", "public,public,public,private,private,private,database,database,database"),
+					resource.TestCheckOutput("availability_zone_indexes", "0,1,2,0,1,2,0,1,2"),
+					resource.TestCheckOutput("usable_hosts", "251,251,251,4091,4091,4091,59,59,59"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_insufficientAddressSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/24", 3, `[{ name = "public", netmask_length = 25 }]`),
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*have[\s\n]*enough[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_duplicateTier(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 2, `[{ name = "public", netmask_length = 24 }, { name = "public", netmask_length = 24 }]`),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*tier[\s\n]*name`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidNetmaskLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 2, `[{ name = "public", netmask_length = 29 }]`),
+				ExpectError: regexache.MustCompile(`netmask_length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig(cidr string, azCount int, tiers string) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::vpc_subnet_plan(%[1]q, %[2]d, %[3]s)
+}
+
+output "cidr_blocks" {
+  value = join(",", local.plan[*].cidr_block)
+}
+
+output "tiers" {
+  value = join(",", local.plan[*].tier)
+}
+
+output "availability_zone_indexes" {
+  value = join(",", local.plan[*].availability_zone_index)
+}
+
+output "usable_hosts" {
+  value = join(",", local.plan[*].usable_hosts)
+}
+`, cidr, azCount, tiers)
+}
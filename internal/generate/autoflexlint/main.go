@@ -0,0 +1,539 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+// autoflexlint statically checks the AutoFlex field mappings of Plugin Framework resources.
+//
+// AutoFlex silently skips fields that it cannot match, so a renamed AWS SDK for Go v2 field can drop data without any error.
+// For each Plugin Framework resource (a file containing a `@FrameworkResource` annotation), every `flex.Expand` and
+// `flex.Flatten` call is found and the fields of its source and target struct types, and of their nested object types,
+// are matched using the same rules as AutoFlex does at runtime.
+//
+// Type information is loaded from the Go module cache, so once dependencies have been downloaded no network access is needed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"iter"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	flexPackagePath    = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypesPackagePath = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var (
+	annotation = regexache.MustCompile(`^//\s*@FrameworkResource(\(([^)]*)\))?\s*$`)
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<package-pattern> ...]\n\n")
+	fmt.Fprintf(os.Stderr, "Package patterns default to ./internal/service/...\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetPrefix("autoflexlint: ")
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./internal/service/..."}
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		// Never download modules; all type information comes from the local module cache.
+		Env: append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off"),
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		log.Fatalf("loading packages: %s", err)
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		log.Fatal("packages contain errors")
+	}
+
+	var resources []*resourceFindings
+	for _, pkg := range pkgs {
+		resources = append(resources, lintPackage(pkg)...)
+	}
+
+	slices.SortFunc(resources, func(a, b *resourceFindings) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	var n int
+	for _, r := range resources {
+		if len(r.findings) == 0 {
+			continue
+		}
+
+		fmt.Printf("%s (%s)\n", r.name, r.filename)
+		for _, f := range r.findings {
+			fmt.Printf("\t%s\n", f)
+			n++
+		}
+	}
+
+	if n > 0 {
+		log.Fatalf("%d AutoFlex field mapping problems found", n)
+	}
+}
+
+// resourceFindings are the problems found in a resource's AutoFlex field mappings.
+type resourceFindings struct {
+	name     string
+	filename string
+	findings []string
+	seen     map[string]bool
+}
+
+func (r *resourceFindings) add(pos token.Position, finding string) {
+	// The same types are often converted in several places, e.g. for Create and Update.
+	if r.seen[finding] {
+		return
+	}
+	r.seen[finding] = true
+
+	r.findings = append(r.findings, fmt.Sprintf("%s:%d: %s", filepath.Base(pos.Filename), pos.Line, finding))
+}
+
+func lintPackage(pkg *packages.Package) []*resourceFindings {
+	// Package-level variables, such as shared AutoFlex options, and their initial values.
+	vars := make(map[types.Object]ast.Expr)
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				if len(valueSpec.Names) != len(valueSpec.Values) {
+					continue
+				}
+				for i, name := range valueSpec.Names {
+					vars[pkg.TypesInfo.Defs[name]] = valueSpec.Values[i]
+				}
+			}
+		}
+	}
+
+	var result []*resourceFindings
+	for _, file := range pkg.Syntax {
+		names := frameworkResourceNames(file)
+		if len(names) == 0 {
+			continue
+		}
+
+		l := &linter{
+			pkg:  pkg,
+			vars: vars,
+			resource: &resourceFindings{
+				name:     strings.Join(names, ", "),
+				filename: pkg.Fset.Position(file.Pos()).Filename,
+				seen:     make(map[string]bool),
+			},
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok {
+				l.lintCall(call)
+			}
+			return true
+		})
+
+		result = append(result, l.resource)
+	}
+
+	return result
+}
+
+// frameworkResourceNames returns the type names of the Plugin Framework resources annotated in the specified file.
+func frameworkResourceNames(file *ast.File) []string {
+	var names []string
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Doc == nil {
+			continue
+		}
+
+		for _, line := range funcDecl.Doc.List {
+			if m := annotation.FindStringSubmatch(line.Text); len(m) > 0 {
+				name := funcDecl.Name.Name
+				// The first argument, if any, is the resource type name.
+				if arg, _, _ := strings.Cut(m[2], ","); arg != "" && !strings.Contains(arg, "=") {
+					name = strings.Trim(strings.TrimSpace(arg), `"`)
+				}
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+type direction string
+
+const (
+	directionExpand  direction = "Expand"
+	directionFlatten direction = "Flatten"
+)
+
+type linter struct {
+	pkg      *packages.Package
+	vars     map[types.Object]ast.Expr
+	resource *resourceFindings
+}
+
+// lintCall checks the field mappings of a `flex.Expand` or `flex.Flatten` call.
+func (l *linter) lintCall(call *ast.CallExpr) {
+	var d direction
+	switch l.flexFunc(call) {
+	case "Expand":
+		d = directionExpand
+	case "Flatten":
+		d = directionFlatten
+	default:
+		return
+	}
+
+	if len(call.Args) < 3 {
+		return
+	}
+
+	pos := l.pkg.Fset.Position(call.Pos())
+
+	optFns, ok := l.options(call.Args[3:])
+	if !ok || call.Ellipsis.IsValid() {
+		l.resource.add(pos, fmt.Sprintf("%s: AutoFlex options are not statically known, defaults are assumed", d))
+	}
+
+	from, to := structOf(l.pkg.TypesInfo.TypeOf(call.Args[1])), structOf(l.pkg.TypesInfo.TypeOf(call.Args[2]))
+	if from == nil || to == nil {
+		return
+	}
+
+	l.lintStructs(pos, d, from, to, flex.NewFieldMatcher(optFns...), make(map[[2]types.Type]bool))
+}
+
+// flexFunc returns the name of the AutoFlex function called, or "" if the call isn't to an AutoFlex function.
+func (l *linter) flexFunc(call *ast.CallExpr) string {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.Ident:
+		ident = fun
+	default:
+		return ""
+	}
+
+	if fn, ok := l.pkg.TypesInfo.Uses[ident].(*types.Func); ok && fn.Pkg() != nil && fn.Pkg().Path() == flexPackagePath {
+		return fn.Name()
+	}
+
+	return ""
+}
+
+// options returns the AutoFlex options passed to a call.
+// Returns false if any option's value isn't statically known.
+func (l *linter) options(args []ast.Expr) ([]flex.AutoFlexOptionsFunc, bool) {
+	var optFns []flex.AutoFlexOptionsFunc
+	known := true
+
+	for _, arg := range args {
+		optFn, ok := l.option(arg)
+		if !ok {
+			known = false
+			continue
+		}
+		optFns = append(optFns, optFn)
+	}
+
+	return optFns, known
+}
+
+func (l *linter) option(arg ast.Expr) (flex.AutoFlexOptionsFunc, bool) {
+	arg = ast.Unparen(arg)
+
+	// Shared options are often declared as package-level variables.
+	if ident, ok := arg.(*ast.Ident); ok {
+		if v, ok := l.vars[l.pkg.TypesInfo.Uses[ident]]; ok {
+			return l.option(v)
+		}
+		return nil, false
+	}
+
+	call, ok := arg.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	switch name := l.flexFunc(call); name {
+	case "WithNoIgnoredFieldNames":
+		return flex.WithNoIgnoredFieldNames(), true
+
+	case "WithFieldNamePrefix", "WithFieldNameSuffix", "WithIgnoredFieldNamesAppend":
+		if len(call.Args) != 1 {
+			return nil, false
+		}
+		s, ok := l.stringConstant(call.Args[0])
+		if !ok {
+			return nil, false
+		}
+		switch name {
+		case "WithFieldNamePrefix":
+			return flex.WithFieldNamePrefix(s), true
+		case "WithFieldNameSuffix":
+			return flex.WithFieldNameSuffix(s), true
+		default:
+			return flex.WithIgnoredFieldNamesAppend(s), true
+		}
+
+	case "WithIgnoredFieldNames":
+		if len(call.Args) != 1 {
+			return nil, false
+		}
+		lit, ok := ast.Unparen(call.Args[0]).(*ast.CompositeLit)
+		if !ok {
+			return nil, false
+		}
+		var fieldNames []string
+		for _, elt := range lit.Elts {
+			s, ok := l.stringConstant(elt)
+			if !ok {
+				return nil, false
+			}
+			fieldNames = append(fieldNames, s)
+		}
+		return flex.WithIgnoredFieldNames(fieldNames), true
+	}
+
+	return nil, false
+}
+
+func (l *linter) stringConstant(expr ast.Expr) (string, bool) {
+	if tv, ok := l.pkg.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return constant.StringVal(tv.Value), true
+	}
+
+	return "", false
+}
+
+// lintStructs checks the field mappings between two struct types and, recursively, between their nested object types.
+func (l *linter) lintStructs(pos token.Position, d direction, from, to *types.Named, matcher *flex.FieldMatcher, visited map[[2]types.Type]bool) {
+	key := [2]types.Type{from, to}
+	if visited[key] {
+		return
+	}
+	visited[key] = true
+
+	fromFields, toFields := newStructFieldNames(from), newStructFieldNames(to)
+	prefix := fmt.Sprintf("%s %s -> %s", d, typeName(from), typeName(to))
+
+	matchedBy := make(map[string][]string) // Target field name -> source field names.
+	for fieldNameFrom := range fromFields.ExportedFieldNames() {
+		if matcher.IsIgnoredField(fieldNameFrom) {
+			continue
+		}
+		if d == directionExpand && (fieldNameFrom == "MapBlockKey" || fromFields.autoflexName(fieldNameFrom) == "-") {
+			continue
+		}
+
+		fieldNameTo, ok := matcher.MatchField(fieldNameFrom, fromFields, toFields)
+		if !ok {
+			// For Expand, a Terraform field that isn't matched is never sent to AWS.
+			// For Flatten, unmatched AWS fields are expected; unpopulated Terraform fields are reported below.
+			if d == directionExpand {
+				l.resource.add(pos, fmt.Sprintf("%s: unmatched source field %q", prefix, fieldNameFrom))
+			}
+			continue
+		}
+		matchedBy[fieldNameTo] = append(matchedBy[fieldNameTo], fieldNameFrom)
+
+		if nestedFrom, nestedTo := structOf(fromFields.fieldType(fieldNameFrom)), structOf(toFields.fieldType(fieldNameTo)); nestedFrom != nil && nestedTo != nil {
+			l.lintStructs(pos, d, nestedFrom, nestedTo, matcher, visited)
+		}
+	}
+
+	for _, fieldNameTo := range slices.Sorted(maps.Keys(matchedBy)) {
+		if v := matchedBy[fieldNameTo]; len(v) > 1 {
+			l.resource.add(pos, fmt.Sprintf("%s: ambiguous target field %q matched by source fields %q", prefix, fieldNameTo, v))
+		}
+	}
+
+	if d == directionFlatten {
+		for fieldNameTo := range toFields.ExportedFieldNames() {
+			if _, ok := matchedBy[fieldNameTo]; ok || matcher.IsIgnoredField(fieldNameTo) {
+				continue
+			}
+			if name := toFields.autoflexName(fieldNameTo); name == "-" || toFields.hasAutoflexOption(fieldNameTo, "noflatten") {
+				continue
+			}
+			l.resource.add(pos, fmt.Sprintf("%s: unmatched target field %q", prefix, fieldNameTo))
+		}
+	}
+}
+
+// structOf returns the named struct type that AutoFlex converts to or from for a value of the specified type.
+// Pointers, slices and maps are dereferenced and the element types of Plugin Framework nested object types are used.
+// Returns nil if there is no such struct type.
+func structOf(typ types.Type) *types.Named {
+	for typ != nil {
+		switch t := types.Unalias(typ).(type) {
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Map:
+			typ = t.Elem()
+		case *types.Named:
+			if obj := t.Obj(); obj.Pkg() != nil && obj.Pkg().Path() == fwtypesPackagePath {
+				if t.TypeArgs().Len() != 1 {
+					return nil
+				}
+				typ = t.TypeArgs().At(0)
+				continue
+			}
+			if _, ok := t.Underlying().(*types.Struct); !ok {
+				return nil
+			}
+			return t
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func typeName(typ *types.Named) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		return pkg.Name()
+	})
+}
+
+// structFieldNames implements flex.FieldNames for a struct type known via static type information.
+// Embedded structs are handled in the same way as by reflection.
+type structFieldNames struct {
+	fields map[string]*types.Var // Field name -> shallowest field with that name.
+	tags   map[string]string     // Field name -> struct tag.
+	order  []string              // Exported field names in declaration order.
+}
+
+var _ flex.FieldNames = (*structFieldNames)(nil)
+
+func newStructFieldNames(typ *types.Named) *structFieldNames {
+	n := &structFieldNames{
+		fields: make(map[string]*types.Var),
+		tags:   make(map[string]string),
+	}
+	s := typ.Underlying().(*types.Struct)
+	n.addFields(s)
+	n.addExportedFieldNames(s)
+
+	return n
+}
+
+// addFields adds the struct's fields, breadth first so that promoted fields are shadowed by shallower fields of the same name.
+func (n *structFieldNames) addFields(s *types.Struct) {
+	var embedded []*types.Struct
+
+	for i := range s.NumFields() {
+		field := s.Field(i)
+
+		if _, ok := n.fields[field.Name()]; !ok {
+			n.fields[field.Name()] = field
+			n.tags[field.Name()] = s.Tag(i)
+		}
+
+		if field.Embedded() {
+			if v, ok := derefStruct(field.Type()); ok {
+				embedded = append(embedded, v)
+			}
+		}
+	}
+
+	for _, v := range embedded {
+		n.addFields(v)
+	}
+}
+
+// addExportedFieldNames adds the struct's exported field names, depth first so that the fields of an embedded struct
+// are at its position.
+func (n *structFieldNames) addExportedFieldNames(s *types.Struct) {
+	for i := range s.NumFields() {
+		field := s.Field(i)
+
+		if field.Embedded() {
+			if v, ok := derefStruct(field.Type()); ok {
+				n.addExportedFieldNames(v)
+			}
+			continue
+		}
+
+		if field.Exported() {
+			n.order = append(n.order, field.Name())
+		}
+	}
+}
+
+func derefStruct(typ types.Type) (*types.Struct, bool) {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	s, ok := typ.Underlying().(*types.Struct)
+	return s, ok
+}
+
+func (n *structFieldNames) HasField(name string) bool {
+	_, ok := n.fields[name]
+	return ok
+}
+
+func (n *structFieldNames) ExportedFieldNames() iter.Seq[string] {
+	return slices.Values(n.order)
+}
+
+func (n *structFieldNames) fieldType(name string) types.Type {
+	if v, ok := n.fields[name]; ok {
+		return v.Type()
+	}
+	return nil
+}
+
+func (n *structFieldNames) autoflexTag(name string) []string {
+	return strings.Split(reflect.StructTag(n.tags[name]).Get("autoflex"), ",")
+}
+
+func (n *structFieldNames) autoflexName(name string) string {
+	return n.autoflexTag(name)[0]
+}
+
+func (n *structFieldNames) hasAutoflexOption(name, option string) bool {
+	return slices.Contains(n.autoflexTag(name)[1:], option)
+}
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+const (
+	KeyMaxConcurrentRequestsLimit = "tf_aws.max_concurrent_requests.limit"
+)
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	KeyRateLimitBudget            = "tf_aws.rate_limit.budget"
+	KeyRateLimitDelay             = "tf_aws.rate_limit.delay"
+	KeyRateLimitRequestsPerSecond = "tf_aws.rate_limit.requests_per_second"
+	KeyRateLimitThrottles         = "tf_aws.rate_limit.throttles"
+	KeyRateLimitWaits             = "tf_aws.rate_limit.waits"
+)
+
+// RateLimitWait logs that a request waited for a client-side rate limit budget.
+// waits is the total number of requests that have waited for the budget.
+func RateLimitWait(ctx context.Context, budget string, delay time.Duration, waits int64) {
+	tflog.Debug(ctx, "Waiting for client-side rate limit", map[string]any{
+		KeyRateLimitBudget: budget,
+		KeyRateLimitDelay:  delay.String(),
+		KeyRateLimitWaits:  waits,
+	})
+}
+
+// RateLimitThrottle logs that a request subject to a client-side rate limit budget was throttled by AWS.
+// throttles is the total number of throttled requests for the budget.
+func RateLimitThrottle(ctx context.Context, budget string, throttles int64) {
+	tflog.Warn(ctx, "Request throttled despite client-side rate limit", map[string]any{
+		KeyRateLimitBudget:    budget,
+		KeyRateLimitThrottles: throttles,
+	})
+}
@@ -135,6 +135,12 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"max_requests_per_second": schema.MapAttribute{
+				ElementType: types.Float64Type,
+				Optional:    true,
+				Description: "Client-side request-per-second budgets for AWS API requests. " +
+					"Keys are a service name, such as `route53`, or a service name and API operation name, such as `route53:ChangeResourceRecordSets`.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -202,11 +208,17 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys, tag key capitalization, and allowed tag values by resource type. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `Path to a local JSON tag policy document, using the same syntax as AWS Organizations tag policies. ` +
+					`When set, tag policy compliance is checked against this document instead of the effective tag policy retrieved from AWS. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -347,6 +359,22 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"max_concurrent_requests": schema.SetNestedBlock{
+				Description: "Configuration block with settings to limit the number of concurrent AWS API requests to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "Maximum number of concurrent requests to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required: true,
+							Description: "Service whose concurrent requests are limited. " +
+								"Valid values are the service names that can be used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -405,7 +433,11 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRUsableHostsFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
 
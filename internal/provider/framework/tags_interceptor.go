@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -263,7 +264,8 @@ func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttype
 	}
 }
 
-// resourceValidateRequiredTags validates that required tags are present for a given resource type.
+// resourceValidateRequiredTags validates that required tags are present for a given resource type,
+// and that tags enforced by the organizational tag policy use the required key capitalization and an allowed value.
 func resourceValidateRequiredTags() resourceModifyPlanInterceptor {
 	return &resourceValidateRequiredTagsInterceptor{}
 }
@@ -282,8 +284,9 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	reqTags, hasReqTags := policy.RequiredTags[typeName]
+	tagRules, hasTagRules := policy.TagRules[typeName]
+	if !hasReqTags && !hasTagRules {
 		return
 	}
 
@@ -315,21 +318,24 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
-		}
-
 		missing := reqTags.Removed(allPlanTags).Keys()
 		slices.Sort(missing)
+		noncompliant := allPlanTags.NoncompliantTags(tagRules)
+
+		addDiagnostic := func(summary, detail string) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			}
+		}
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		if len(missing) > 0 {
+			addDiagnostic("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+		}
+		if len(noncompliant) > 0 {
+			addDiagnostic("Noncompliant Tags", fmt.Sprintf("The following tags for %s do not comply with an organizational tag policy: %s", typeName, strings.Join(noncompliant, "; ")))
 		}
 	}
 }
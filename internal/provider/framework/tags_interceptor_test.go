@@ -44,6 +44,11 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 				"bar": nil,
 			},
 		},
+		TagRules: map[string][]tftags.TagRule{
+			"aws_test": {
+				{Key: "Env", Values: []string{"prod"}},
+			},
+		},
 	}
 }
 
@@ -117,6 +122,16 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	}
 	rawValRequired := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsRequired)
 
+	// Partial required tags and a noncompliant tag value
+	attrsNoncompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"bar": tftypes.NewValue(tftypes.String, nil),
+			"Env": tftypes.NewValue(tftypes.String, "dev"),
+		}),
+	}
+	rawValNoncompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsNoncompliant)
+
 	// Unknown tag values
 	attrsUnknown := map[string]tftypes.Value{
 		"name": tftypes.NewValue(tftypes.String, "test"),
@@ -198,6 +213,45 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 			),
 			},
 		},
+		{
+			name: "create, partial and noncompliant tags",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockRequiredTagsClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(names.AttrTags),
+					"Missing Required Tags",
+					"An organizational tag policy requires the following tags for aws_test: [foo]",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tags",
+					`The following tags for aws_test do not comply with an organizational tag policy: Env: value "dev" is not one of ["prod"]`,
+				),
+			},
+		},
 		{
 			name: "create, required tags",
 			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
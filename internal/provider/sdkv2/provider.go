@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -154,6 +155,33 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 						"default value is `false`",
 				},
+				"max_concurrent_requests": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Configuration block with settings to limit the number of concurrent AWS API requests to a service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"limit": {
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "Maximum number of concurrent requests to the service.",
+							},
+							"service": {
+								Type:     schema.TypeString,
+								Required: true,
+								Description: "Service whose concurrent requests are limited. " +
+									"Valid values are the service names that can be used in the `endpoints` configuration block.",
+							},
+						},
+					},
+				},
+				"max_requests_per_second": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeFloat},
+					Description: "Client-side request-per-second budgets for AWS API requests. " +
+						"Keys are a service name, such as `route53`, or a service name and API operation name, such as `route53:ChangeResourceRecordSets`.",
+				},
 				"max_retries": {
 					Type:     schema.TypeInt,
 					Optional: true,
@@ -253,11 +281,18 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys, tag key capitalization, and allowed tag values by resource type. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `Path to a local JSON tag policy document, using the same syntax as AWS Organizations tag policies. ` +
+						`When set, tag policy compliance is checked against this document instead of the effective tag policy retrieved from AWS. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -474,13 +509,31 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
-	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string))
+	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string), d.Get("tag_policy_file").(string))
 	diags = append(diags, dg...)
 	if dg.HasError() {
 		return nil, diags
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.GetOk("max_concurrent_requests"); ok && v.(*schema.Set).Len() > 0 {
+		maxConcurrentRequests, dg := expandMaxConcurrentRequests(ctx, cty.GetAttrPath("max_concurrent_requests"), v.(*schema.Set).List())
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.MaxConcurrentRequests = maxConcurrentRequests
+	}
+
+	if v, ok := d.GetOk("max_requests_per_second"); ok && len(v.(map[string]any)) > 0 {
+		maxRequestsPerSecond, dg := expandMaxRequestsPerSecond(ctx, cty.GetAttrPath("max_requests_per_second"), v.(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.MaxRequestsPerSecond = maxRequestsPerSecond
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -1176,16 +1229,111 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
-func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+// expandMaxConcurrentRequests returns the concurrent request limit for each configured service package.
+// Service aliases are resolved to their service package name.
+func expandMaxConcurrentRequests(ctx context.Context, path cty.Path, tfList []any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string]int, len(tfList))
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Unsupported service %q", service)))
+			continue
+		}
+
+		if _, ok := result[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Duplicate service %q", service)))
+			continue
+		}
+
+		limit := tfMap["limit"].(int)
+		if limit < 1 {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Limit for service %q must be at least 1, got %d", service, limit)))
+			continue
+		}
+
+		result[servicePackageName] = limit
+		tflog.Info(ctx, "max_concurrent_requests configuration set", map[string]any{
+			"tf_aws.service_package":              servicePackageName,
+			logging.KeyMaxConcurrentRequestsLimit: limit,
+		})
+	}
+
+	return result, diags
+}
+
+// expandMaxRequestsPerSecond returns the request-per-second budget for each configured service package or API operation.
+// Service aliases are resolved to their service package name.
+func expandMaxRequestsPerSecond(ctx context.Context, path cty.Path, tfMap map[string]any) (map[string]float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string]float64, len(tfMap))
+
+	for k, v := range tfMap {
+		service, operationName, hasOperation := strings.Cut(k, ":")
+		if hasOperation && operationName == "" {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Invalid key %q, must be a service name or <service>:<operation>", k)))
+			continue
+		}
+
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Unsupported service %q in key %q", service, k)))
+			continue
+		}
+
+		key := servicePackageName
+		if hasOperation {
+			key += ":" + operationName
+		}
+
+		if _, ok := result[key]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Duplicate key %q", k)))
+			continue
+		}
+
+		rps := v.(float64)
+		if rps <= 0 {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path, fmt.Sprintf("Budget for %q must be greater than 0, got %v", k, rps)))
+			continue
+		}
+
+		result[key] = rps
+		tflog.Info(ctx, "max_requests_per_second configuration set", map[string]any{
+			logging.KeyRateLimitBudget:            key,
+			logging.KeyRateLimitRequestsPerSecond: rps,
+		})
+	}
+
+	return result, diags
+}
+
+func expandTagPolicyConfig(path cty.Path, severity, policyFile string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+	var tagPolicyConfig *tftags.TagPolicyConfig
+	var diags diag.Diagnostics
+
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
 	case severity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
+		tagPolicyConfig, diags = &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
 	case envSeverity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+		tagPolicyConfig, diags = &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+	default:
+		return nil, nil
+	}
+
+	if policyFile == "" {
+		policyFile = os.Getenv(tftags.TagPolicyFileEnvVar)
 	}
+	tagPolicyConfig.PolicyFile = policyFile
 
-	return nil, nil
+	return tagPolicyConfig, diags
 }
 
 func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
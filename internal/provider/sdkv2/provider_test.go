@@ -439,3 +439,157 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]int
+		expectedError bool
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]int{},
+		},
+		"services": {
+			tfList: []any{
+				map[string]any{"service": "route53", "limit": 2},
+				map[string]any{"service": "iam", "limit": 5},
+			},
+			expected: map[string]int{
+				names.Route53: 2,
+				names.IAM:     5,
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{"service": "cloudwatchlog", "limit": 3},
+			},
+			expected: map[string]int{
+				names.Logs: 3,
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{"service": "notaservice", "limit": 3},
+			},
+			expectedError: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "logs", "limit": 3},
+				map[string]any{"service": "cloudwatchlogs", "limit": 4},
+			},
+			expectedError: true,
+		},
+		"zero limit": {
+			tfList: []any{
+				map[string]any{"service": "iam", "limit": 0},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandMaxConcurrentRequests(ctx, cty.GetAttrPath("max_concurrent_requests"), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expected error %t, got diagnostics: %v", want, diags)
+			}
+
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandMaxRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testcases := map[string]struct {
+		tfMap         map[string]any
+		expected      map[string]float64
+		expectedError bool
+	}{
+		"empty": {
+			tfMap:    map[string]any{},
+			expected: map[string]float64{},
+		},
+		"services and operations": {
+			tfMap: map[string]any{
+				"route53":                          10.0,
+				"route53:ChangeResourceRecordSets": 5.0,
+				"iam:CreateRole":                   0.5,
+			},
+			expected: map[string]float64{
+				names.Route53:                      10,
+				"route53:ChangeResourceRecordSets": 5,
+				"iam:CreateRole":                   0.5,
+			},
+		},
+		"alias": {
+			tfMap: map[string]any{
+				"cloudwatchlog:PutRetentionPolicy": 2.0,
+			},
+			expected: map[string]float64{
+				"logs:PutRetentionPolicy": 2,
+			},
+		},
+		"unknown service": {
+			tfMap: map[string]any{
+				"notaservice:CreateThing": 1.0,
+			},
+			expectedError: true,
+		},
+		"empty operation": {
+			tfMap: map[string]any{
+				"iam:": 1.0,
+			},
+			expectedError: true,
+		},
+		"duplicate budget": {
+			tfMap: map[string]any{
+				"logs:PutRetentionPolicy":           1.0,
+				"cloudwatchlogs:PutRetentionPolicy": 2.0,
+			},
+			expectedError: true,
+		},
+		"zero budget": {
+			tfMap: map[string]any{
+				"iam": 0.0,
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandMaxRequestsPerSecond(ctx, cty.GetAttrPath("max_requests_per_second"), testcase.tfMap)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expected error %t, got diagnostics: %v", want, diags)
+			}
+
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -300,6 +302,9 @@ func setTagsAll() customizeDiffInterceptor {
 	})
 }
 
+// validateRequiredTags validates that a resource's tags comply with the
+// organizational tag policy. Required tags must be present, and tags enforced
+// by the policy must use the required key capitalization and an allowed value.
 func validateRequiredTags() customizeDiffInterceptor {
 	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
 		c := opts.c
@@ -313,8 +318,9 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		reqTags, hasReqTags := policy.RequiredTags[typeName]
+		tagRules, hasTagRules := policy.TagRules[typeName]
+		if !hasReqTags && !hasTagRules {
 			return nil
 		}
 
@@ -335,27 +341,45 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
-				}
 
 				missing := reqTags.Removed(allTags).Keys()
 				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+				noncompliant := allTags.NoncompliantTags(tagRules)
 
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
+				type tagPolicyViolation struct {
+					summary, detail string
+				}
+				var violations []tagPolicyViolation
+				if len(missing) > 0 {
+					violations = append(violations, tagPolicyViolation{
+						summary: "Missing Required Tags",
+						detail:  fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing),
+					})
+				}
+				if len(noncompliant) > 0 {
+					violations = append(violations, tagPolicyViolation{
+						summary: "Noncompliant Tags",
+						detail:  fmt.Sprintf("The following tags for %s do not comply with an organizational tag policy: %s", typeName, strings.Join(noncompliant, "; ")),
 					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
 				}
+
+				// CustomizeDiff does not support diagnostics (only an error return)
+				var errs []error
+				for _, v := range violations {
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "Required Tags Validation", map[string]any{
+							"summary": v.summary,
+							"detail":  v.detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", v.summary, v.detail))
+					}
+				}
+
+				return errors.Join(errs...)
 			}
 		}
 
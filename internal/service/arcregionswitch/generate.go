@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tags/main.go -ServiceTagsMap -KVTValues -ListTags -ListTagsInIDElem=Arn -ListTagsOutTagsElem=ResourceTags -UpdateTags -TagInIDElem=Arn -UntagInTagsElem=ResourceTagKeys
+//go:generate go run ../../generate/identitytests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package arcregionswitch
@@ -0,0 +1,1163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_arcregionswitch_plan", name="Plan")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Region(global=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types;awstypes;awstypes.Plan")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(tagsTest=false)
+// @Testing(hasNoPreExistingResource=true)
+func newPlanResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &planResource{}, nil
+}
+
+type planResource struct {
+	framework.ResourceWithModel[planResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *planResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"execution_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_region": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recovery_approach": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RecoveryApproach](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recovery_time_objective_minutes": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"regions": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Required:   true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"associated_alarm": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[associatedAlarmModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"alarm_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AlarmType](),
+							Required:   true,
+						},
+						"cross_account_role": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						names.AttrExternalID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"resource_identifier": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"trigger": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[triggerModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAction: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.WorkflowTargetAction](),
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"min_delay_minutes_between_executions": schema.Int32Attribute{
+							Required: true,
+						},
+						"target_region": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrCondition: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[triggerConditionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"associated_alarm_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrCondition: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AlarmCondition](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"workflow": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[workflowModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"workflow_description": schema.StringAttribute{
+							Optional: true,
+						},
+						"workflow_target_action": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.WorkflowTargetAction](),
+							Required:   true,
+						},
+						"workflow_target_region": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"step": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stepModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDescription: schema.StringAttribute{
+										Optional: true,
+									},
+									"execution_block_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ExecutionBlockType](),
+										Required:   true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"execution_block_configuration": executionBlockConfigurationBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func executionBlockConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	crossAccountAttributes := func(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+		attributes["cross_account_role"] = schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Optional:   true,
+		}
+		attributes[names.AttrExternalID] = schema.StringAttribute{
+			Optional: true,
+		}
+		return attributes
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[executionBlockConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"arc_routing_control_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[arcRoutingControlConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: crossAccountAttributes(map[string]schema.Attribute{
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						}),
+						Blocks: map[string]schema.Block{
+							"region_and_routing_controls": schema.SetNestedBlock{
+								CustomType: fwtypes.NewSetNestedObjectTypeOf[regionAndRoutingControlsModel](ctx),
+								Validators: []validator.Set{
+									setvalidator.IsRequired(),
+									setvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrRegion: schema.StringAttribute{
+											Required: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"routing_control": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[arcRoutingControlStateModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtLeast(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"routing_control_arn": schema.StringAttribute{
+														CustomType: fwtypes.ARNType,
+														Required:   true,
+													},
+													names.AttrState: schema.StringAttribute{
+														CustomType: fwtypes.StringEnumType[awstypes.RoutingControlStateChange](),
+														Required:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"custom_action_lambda_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[customActionLambdaConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"region_to_run": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RegionToRunIn](),
+								Required:   true,
+							},
+							"retry_interval_minutes": schema.Float32Attribute{
+								Required: true,
+							},
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"lambda": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: crossAccountAttributes(map[string]schema.Attribute{
+										names.AttrARN: schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									}),
+								},
+							},
+							"ungraceful": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaUngracefulModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"behavior": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.LambdaUngracefulBehavior](),
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"ec2_asg_capacity_increase_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ec2AsgCapacityIncreaseConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"capacity_monitoring_approach": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.Ec2AsgCapacityMonitoringApproach](),
+								Optional:   true,
+								Computed:   true,
+							},
+							"target_percent": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"asg": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[asgModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: crossAccountAttributes(map[string]schema.Attribute{
+										names.AttrARN: schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									}),
+								},
+							},
+							"ungraceful": capacityIncreaseUngracefulBlock(ctx),
+						},
+					},
+				},
+				"ecs_capacity_increase_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ecsCapacityIncreaseConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"capacity_monitoring_approach": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.EcsCapacityMonitoringApproach](),
+								Optional:   true,
+								Computed:   true,
+							},
+							"target_percent": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"service": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[serviceModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: crossAccountAttributes(map[string]schema.Attribute{
+										"cluster_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+										"service_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									}),
+								},
+							},
+							"ungraceful": capacityIncreaseUngracefulBlock(ctx),
+						},
+					},
+				},
+				"execution_approval_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[executionApprovalConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"approval_role": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"global_aurora_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[globalAuroraConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: crossAccountAttributes(map[string]schema.Attribute{
+							"behavior": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.GlobalAuroraDefaultBehavior](),
+								Required:   true,
+							},
+							"database_cluster_arns": schema.ListAttribute{
+								CustomType: fwtypes.ListOfARNType,
+								Required:   true,
+							},
+							"global_cluster_identifier": schema.StringAttribute{
+								Required: true,
+							},
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						}),
+						Blocks: map[string]schema.Block{
+							"ungraceful": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[globalAuroraUngracefulModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"ungraceful": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.GlobalAuroraUngracefulBehavior](),
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"region_switch_plan_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[regionSwitchPlanConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: crossAccountAttributes(map[string]schema.Attribute{
+							names.AttrARN: schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						}),
+					},
+				},
+				"route53_health_check_config": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[route53HealthCheckConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: crossAccountAttributes(map[string]schema.Attribute{
+							names.AttrHostedZoneID: schema.StringAttribute{
+								Required: true,
+							},
+							"record_name": schema.StringAttribute{
+								Required: true,
+							},
+							"timeout_minutes": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+							},
+						}),
+						Blocks: map[string]schema.Block{
+							"record_set": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[route53ResourceRecordSetModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"record_set_identifier": schema.StringAttribute{
+											Optional: true,
+										},
+										names.AttrRegion: schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func capacityIncreaseUngracefulBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[capacityIncreaseUngracefulModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"minimum_success_percentage": schema.Int32Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *planResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data planResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCRegionSwitchClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input arcregionswitch.CreatePlanInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePlan(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ARC Region Switch Plan (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Plan, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *planResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data planResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCRegionSwitchClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findPlanByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ARC Region Switch Plan (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *planResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old planResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCRegionSwitchClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+		var input arcregionswitch.UpdatePlanInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdatePlan(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ARC Region Switch Plan (%s)", arn), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Plan, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.Version = old.Version
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *planResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data planResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCRegionSwitchClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	input := arcregionswitch.DeletePlanInput{
+		Arn: aws.String(arn),
+	}
+	_, err := conn.DeletePlan(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ARC Region Switch Plan (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func findPlanByARN(ctx context.Context, conn *arcregionswitch.Client, arn string) (*awstypes.Plan, error) {
+	input := arcregionswitch.GetPlanInput{
+		Arn: aws.String(arn),
+	}
+
+	return findPlan(ctx, conn, &input)
+}
+
+func findPlan(ctx context.Context, conn *arcregionswitch.Client, input *arcregionswitch.GetPlanInput) (*awstypes.Plan, error) {
+	output, err := conn.GetPlan(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Plan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Plan, nil
+}
+
+func listPlans(ctx context.Context, conn *arcregionswitch.Client, input *arcregionswitch.ListPlansInput) iter.Seq2[awstypes.AbbreviatedPlan, error] {
+	return func(yield func(awstypes.AbbreviatedPlan, error) bool) {
+		pages := arcregionswitch.NewListPlansPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.AbbreviatedPlan{}, fmt.Errorf("listing ARC Region Switch Plans: %w", err))
+				return
+			}
+
+			for _, v := range page.Plans {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type planResourceModel struct {
+	ARN                          types.String                                         `tfsdk:"arn"`
+	AssociatedAlarms             fwtypes.SetNestedObjectValueOf[associatedAlarmModel] `tfsdk:"associated_alarm"`
+	Description                  types.String                                         `tfsdk:"description"`
+	ExecutionRole                fwtypes.ARN                                          `tfsdk:"execution_role"`
+	Name                         types.String                                         `tfsdk:"name"`
+	Owner                        types.String                                         `tfsdk:"owner"`
+	PrimaryRegion                types.String                                         `tfsdk:"primary_region"`
+	RecoveryApproach             fwtypes.StringEnum[awstypes.RecoveryApproach]        `tfsdk:"recovery_approach"`
+	RecoveryTimeObjectiveMinutes types.Int32                                          `tfsdk:"recovery_time_objective_minutes"`
+	Regions                      fwtypes.ListOfString                                 `tfsdk:"regions"`
+	Tags                         tftags.Map                                           `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                           `tfsdk:"tags_all"`
+	Triggers                     fwtypes.ListNestedObjectValueOf[triggerModel]        `tfsdk:"trigger"`
+	Version                      types.String                                         `tfsdk:"version"`
+	Workflows                    fwtypes.ListNestedObjectValueOf[workflowModel]       `tfsdk:"workflow"`
+}
+
+type associatedAlarmModel struct {
+	AlarmType          fwtypes.StringEnum[awstypes.AlarmType] `tfsdk:"alarm_type"`
+	CrossAccountRole   fwtypes.ARN                            `tfsdk:"cross_account_role"`
+	ExternalID         types.String                           `tfsdk:"external_id"`
+	MapBlockKey        types.String                           `tfsdk:"name"`
+	ResourceIdentifier types.String                           `tfsdk:"resource_identifier"`
+}
+
+type triggerModel struct {
+	Action                           fwtypes.StringEnum[awstypes.WorkflowTargetAction]      `tfsdk:"action"`
+	Conditions                       fwtypes.ListNestedObjectValueOf[triggerConditionModel] `tfsdk:"condition"`
+	Description                      types.String                                           `tfsdk:"description"`
+	MinDelayMinutesBetweenExecutions types.Int32                                            `tfsdk:"min_delay_minutes_between_executions"`
+	TargetRegion                     types.String                                           `tfsdk:"target_region"`
+}
+
+type triggerConditionModel struct {
+	AssociatedAlarmName types.String                                `tfsdk:"associated_alarm_name"`
+	Condition           fwtypes.StringEnum[awstypes.AlarmCondition] `tfsdk:"condition"`
+}
+
+type workflowModel struct {
+	Steps                fwtypes.ListNestedObjectValueOf[stepModel]        `tfsdk:"step"`
+	WorkflowDescription  types.String                                      `tfsdk:"workflow_description"`
+	WorkflowTargetAction fwtypes.StringEnum[awstypes.WorkflowTargetAction] `tfsdk:"workflow_target_action"`
+	WorkflowTargetRegion types.String                                      `tfsdk:"workflow_target_region"`
+}
+
+type stepModel struct {
+	Description                 types.String                                                      `tfsdk:"description"`
+	ExecutionBlockConfiguration fwtypes.ListNestedObjectValueOf[executionBlockConfigurationModel] `tfsdk:"execution_block_configuration"`
+	ExecutionBlockType          fwtypes.StringEnum[awstypes.ExecutionBlockType]                   `tfsdk:"execution_block_type"`
+	Name                        types.String                                                      `tfsdk:"name"`
+}
+
+// executionBlockConfigurationModel represents the ExecutionBlockConfiguration union.
+// Parallel and EKS resource scaling execution blocks are not supported.
+type executionBlockConfigurationModel struct {
+	ArcRoutingControlConfig      fwtypes.ListNestedObjectValueOf[arcRoutingControlConfigurationModel]      `tfsdk:"arc_routing_control_config"`
+	CustomActionLambdaConfig     fwtypes.ListNestedObjectValueOf[customActionLambdaConfigurationModel]     `tfsdk:"custom_action_lambda_config"`
+	Ec2AsgCapacityIncreaseConfig fwtypes.ListNestedObjectValueOf[ec2AsgCapacityIncreaseConfigurationModel] `tfsdk:"ec2_asg_capacity_increase_config"`
+	EcsCapacityIncreaseConfig    fwtypes.ListNestedObjectValueOf[ecsCapacityIncreaseConfigurationModel]    `tfsdk:"ecs_capacity_increase_config"`
+	ExecutionApprovalConfig      fwtypes.ListNestedObjectValueOf[executionApprovalConfigurationModel]      `tfsdk:"execution_approval_config"`
+	GlobalAuroraConfig           fwtypes.ListNestedObjectValueOf[globalAuroraConfigurationModel]           `tfsdk:"global_aurora_config"`
+	RegionSwitchPlanConfig       fwtypes.ListNestedObjectValueOf[regionSwitchPlanConfigurationModel]       `tfsdk:"region_switch_plan_config"`
+	Route53HealthCheckConfig     fwtypes.ListNestedObjectValueOf[route53HealthCheckConfigurationModel]     `tfsdk:"route53_health_check_config"`
+}
+
+var (
+	_ fwflex.Expander  = executionBlockConfigurationModel{}
+	_ fwflex.Flattener = &executionBlockConfigurationModel{}
+)
+
+func (m executionBlockConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.ArcRoutingControlConfig.IsNull():
+		data, d := m.ArcRoutingControlConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberArcRoutingControlConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		// map[string][]awstypes.ArcRoutingControlState is not supported by AutoFlex.
+		regionAndRoutingControls, d := data.RegionAndRoutingControlStates.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		r.Value.RegionAndRoutingControls = make(map[string][]awstypes.ArcRoutingControlState, len(regionAndRoutingControls))
+		for _, v := range regionAndRoutingControls {
+			var states []awstypes.ArcRoutingControlState
+			diags.Append(fwflex.Expand(ctx, v.RoutingControls, &states)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			r.Value.RegionAndRoutingControls[v.Region.ValueString()] = states
+		}
+
+		return &r, diags
+
+	case !m.CustomActionLambdaConfig.IsNull():
+		data, d := m.CustomActionLambdaConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberCustomActionLambdaConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Ec2AsgCapacityIncreaseConfig.IsNull():
+		data, d := m.Ec2AsgCapacityIncreaseConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberEc2AsgCapacityIncreaseConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.EcsCapacityIncreaseConfig.IsNull():
+		data, d := m.EcsCapacityIncreaseConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberEcsCapacityIncreaseConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.ExecutionApprovalConfig.IsNull():
+		data, d := m.ExecutionApprovalConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberExecutionApprovalConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GlobalAuroraConfig.IsNull():
+		data, d := m.GlobalAuroraConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberGlobalAuroraConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RegionSwitchPlanConfig.IsNull():
+		data, d := m.RegionSwitchPlanConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberRegionSwitchPlanConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Route53HealthCheckConfig.IsNull():
+		data, d := m.Route53HealthCheckConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ExecutionBlockConfigurationMemberRoute53HealthCheckConfig
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *executionBlockConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ExecutionBlockConfigurationMemberArcRoutingControlConfig:
+		var data arcRoutingControlConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		regionAndRoutingControls := make([]*regionAndRoutingControlsModel, 0, len(t.Value.RegionAndRoutingControls))
+		for region, states := range t.Value.RegionAndRoutingControls {
+			rc := regionAndRoutingControlsModel{
+				Region: types.StringValue(region),
+			}
+			diags.Append(fwflex.Flatten(ctx, states, &rc.RoutingControls)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			regionAndRoutingControls = append(regionAndRoutingControls, &rc)
+		}
+		data.RegionAndRoutingControlStates = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, regionAndRoutingControls)
+
+		m.ArcRoutingControlConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberCustomActionLambdaConfig:
+		var data customActionLambdaConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CustomActionLambdaConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberEc2AsgCapacityIncreaseConfig:
+		var data ec2AsgCapacityIncreaseConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Ec2AsgCapacityIncreaseConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberEcsCapacityIncreaseConfig:
+		var data ecsCapacityIncreaseConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.EcsCapacityIncreaseConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberExecutionApprovalConfig:
+		var data executionApprovalConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.ExecutionApprovalConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberGlobalAuroraConfig:
+		var data globalAuroraConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.GlobalAuroraConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberRegionSwitchPlanConfig:
+		var data regionSwitchPlanConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RegionSwitchPlanConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.ExecutionBlockConfigurationMemberRoute53HealthCheckConfig:
+		var data route53HealthCheckConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Route53HealthCheckConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type arcRoutingControlConfigurationModel struct {
+	CrossAccountRole              fwtypes.ARN                                                   `tfsdk:"cross_account_role"`
+	ExternalID                    types.String                                                  `tfsdk:"external_id"`
+	RegionAndRoutingControlStates fwtypes.SetNestedObjectValueOf[regionAndRoutingControlsModel] `tfsdk:"region_and_routing_controls"`
+	TimeoutMinutes                types.Int32                                                   `tfsdk:"timeout_minutes"`
+}
+
+type regionAndRoutingControlsModel struct {
+	Region          types.String                                                 `tfsdk:"region"`
+	RoutingControls fwtypes.ListNestedObjectValueOf[arcRoutingControlStateModel] `tfsdk:"routing_control"`
+}
+
+type arcRoutingControlStateModel struct {
+	RoutingControlARN fwtypes.ARN                                            `tfsdk:"routing_control_arn"`
+	State             fwtypes.StringEnum[awstypes.RoutingControlStateChange] `tfsdk:"state"`
+}
+
+type customActionLambdaConfigurationModel struct {
+	Lambdas              fwtypes.ListNestedObjectValueOf[lambdaModel]           `tfsdk:"lambda"`
+	RegionToRun          fwtypes.StringEnum[awstypes.RegionToRunIn]             `tfsdk:"region_to_run"`
+	RetryIntervalMinutes types.Float32                                          `tfsdk:"retry_interval_minutes"`
+	TimeoutMinutes       types.Int32                                            `tfsdk:"timeout_minutes"`
+	Ungraceful           fwtypes.ListNestedObjectValueOf[lambdaUngracefulModel] `tfsdk:"ungraceful"`
+}
+
+type lambdaModel struct {
+	ARN              fwtypes.ARN  `tfsdk:"arn"`
+	CrossAccountRole fwtypes.ARN  `tfsdk:"cross_account_role"`
+	ExternalID       types.String `tfsdk:"external_id"`
+}
+
+type lambdaUngracefulModel struct {
+	Behavior fwtypes.StringEnum[awstypes.LambdaUngracefulBehavior] `tfsdk:"behavior"`
+}
+
+type ec2AsgCapacityIncreaseConfigurationModel struct {
+	Asgs                       fwtypes.ListNestedObjectValueOf[asgModel]                        `tfsdk:"asg"`
+	CapacityMonitoringApproach fwtypes.StringEnum[awstypes.Ec2AsgCapacityMonitoringApproach]    `tfsdk:"capacity_monitoring_approach"`
+	TargetPercent              types.Int32                                                      `tfsdk:"target_percent"`
+	TimeoutMinutes             types.Int32                                                      `tfsdk:"timeout_minutes"`
+	Ungraceful                 fwtypes.ListNestedObjectValueOf[capacityIncreaseUngracefulModel] `tfsdk:"ungraceful"`
+}
+
+type asgModel struct {
+	ARN              fwtypes.ARN  `tfsdk:"arn"`
+	CrossAccountRole fwtypes.ARN  `tfsdk:"cross_account_role"`
+	ExternalID       types.String `tfsdk:"external_id"`
+}
+
+type capacityIncreaseUngracefulModel struct {
+	MinimumSuccessPercentage types.Int32 `tfsdk:"minimum_success_percentage"`
+}
+
+type ecsCapacityIncreaseConfigurationModel struct {
+	CapacityMonitoringApproach fwtypes.StringEnum[awstypes.EcsCapacityMonitoringApproach]       `tfsdk:"capacity_monitoring_approach"`
+	Services                   fwtypes.ListNestedObjectValueOf[serviceModel]                    `tfsdk:"service"`
+	TargetPercent              types.Int32                                                      `tfsdk:"target_percent"`
+	TimeoutMinutes             types.Int32                                                      `tfsdk:"timeout_minutes"`
+	Ungraceful                 fwtypes.ListNestedObjectValueOf[capacityIncreaseUngracefulModel] `tfsdk:"ungraceful"`
+}
+
+type serviceModel struct {
+	ClusterARN       fwtypes.ARN  `tfsdk:"cluster_arn"`
+	CrossAccountRole fwtypes.ARN  `tfsdk:"cross_account_role"`
+	ExternalID       types.String `tfsdk:"external_id"`
+	ServiceARN       fwtypes.ARN  `tfsdk:"service_arn"`
+}
+
+type executionApprovalConfigurationModel struct {
+	ApprovalRole   fwtypes.ARN `tfsdk:"approval_role"`
+	TimeoutMinutes types.Int32 `tfsdk:"timeout_minutes"`
+}
+
+type globalAuroraConfigurationModel struct {
+	Behavior                fwtypes.StringEnum[awstypes.GlobalAuroraDefaultBehavior]     `tfsdk:"behavior"`
+	CrossAccountRole        fwtypes.ARN                                                  `tfsdk:"cross_account_role"`
+	DatabaseClusterARNs     fwtypes.ListOfARN                                            `tfsdk:"database_cluster_arns"`
+	ExternalID              types.String                                                 `tfsdk:"external_id"`
+	GlobalClusterIdentifier types.String                                                 `tfsdk:"global_cluster_identifier"`
+	TimeoutMinutes          types.Int32                                                  `tfsdk:"timeout_minutes"`
+	Ungraceful              fwtypes.ListNestedObjectValueOf[globalAuroraUngracefulModel] `tfsdk:"ungraceful"`
+}
+
+type globalAuroraUngracefulModel struct {
+	Ungraceful fwtypes.StringEnum[awstypes.GlobalAuroraUngracefulBehavior] `tfsdk:"ungraceful"`
+}
+
+type regionSwitchPlanConfigurationModel struct {
+	ARN              fwtypes.ARN  `tfsdk:"arn"`
+	CrossAccountRole fwtypes.ARN  `tfsdk:"cross_account_role"`
+	ExternalID       types.String `tfsdk:"external_id"`
+}
+
+type route53HealthCheckConfigurationModel struct {
+	CrossAccountRole fwtypes.ARN                                                    `tfsdk:"cross_account_role"`
+	ExternalID       types.String                                                   `tfsdk:"external_id"`
+	HostedZoneID     types.String                                                   `tfsdk:"hosted_zone_id"`
+	RecordName       types.String                                                   `tfsdk:"record_name"`
+	RecordSets       fwtypes.ListNestedObjectValueOf[route53ResourceRecordSetModel] `tfsdk:"record_set"`
+	TimeoutMinutes   types.Int32                                                    `tfsdk:"timeout_minutes"`
+}
+
+type route53ResourceRecordSetModel struct {
+	RecordSetIdentifier types.String `tfsdk:"record_set_identifier"`
+	Region              types.String `tfsdk:"region"`
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource("aws_arcregionswitch_plan_evaluation_status", name="Plan Evaluation Status")
+func newPlanEvaluationStatusDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &planEvaluationStatusDataSource{}, nil
+}
+
+type planEvaluationStatusDataSource struct {
+	framework.DataSourceWithModel[planEvaluationStatusDataSourceModel]
+}
+
+func (d *planEvaluationStatusDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"evaluation_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationStatus](),
+				Computed:   true,
+			},
+			"last_evaluated_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_evaluation_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"plan_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"warnings": framework.DataSourceComputedListOfObjectAttribute[resourceWarningModel](ctx),
+		},
+	}
+}
+
+func (d *planEvaluationStatusDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data planEvaluationStatusDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ARCRegionSwitchClient(ctx)
+
+	planARN := fwflex.StringValueFromFramework(ctx, data.PlanARN)
+	input := arcregionswitch.GetPlanEvaluationStatusInput{
+		PlanArn: aws.String(planARN),
+	}
+	output, err := findPlanEvaluationStatus(ctx, conn, &input, withDataPlaneRegion(d.Meta().Region(ctx)))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ARC Region Switch Plan (%s) evaluation status", planARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Region"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// withDataPlaneRegion returns a functional option that routes ARC Region switch data plane
+// operations to the specified Region rather than the control plane Region.
+func withDataPlaneRegion(region string) func(*arcregionswitch.Options) {
+	return func(o *arcregionswitch.Options) {
+		o.Region = region
+	}
+}
+
+func findPlanEvaluationStatus(ctx context.Context, conn *arcregionswitch.Client, input *arcregionswitch.GetPlanEvaluationStatusInput, optFns ...func(*arcregionswitch.Options)) (*arcregionswitch.GetPlanEvaluationStatusOutput, error) {
+	var output *arcregionswitch.GetPlanEvaluationStatusOutput
+
+	pages := arcregionswitch.NewGetPlanEvaluationStatusPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.Warnings = append(output.Warnings, page.Warnings...)
+		}
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type planEvaluationStatusDataSourceModel struct {
+	framework.WithRegionModel
+	EvaluationState      fwtypes.StringEnum[awstypes.EvaluationStatus]         `tfsdk:"evaluation_state"`
+	LastEvaluatedVersion types.String                                          `tfsdk:"last_evaluated_version"`
+	LastEvaluationTime   timetypes.RFC3339                                     `tfsdk:"last_evaluation_time"`
+	PlanARN              fwtypes.ARN                                           `tfsdk:"plan_arn"`
+	Warnings             fwtypes.ListNestedObjectValueOf[resourceWarningModel] `tfsdk:"warnings"`
+}
+
+type resourceWarningModel struct {
+	ResourceARN        types.String                                          `tfsdk:"resource_arn"`
+	StepName           types.String                                          `tfsdk:"step_name"`
+	Version            types.String                                          `tfsdk:"version"`
+	WarningMessage     types.String                                          `tfsdk:"warning_message"`
+	WarningStatus      fwtypes.StringEnum[awstypes.ResourceWarningStatus]    `tfsdk:"warning_status"`
+	WarningUpdatedTime timetypes.RFC3339                                     `tfsdk:"warning_updated_time"`
+	Workflow           fwtypes.ListNestedObjectValueOf[minimalWorkflowModel] `tfsdk:"workflow"`
+}
+
+type minimalWorkflowModel struct {
+	Action fwtypes.StringEnum[awstypes.ExecutionAction] `tfsdk:"action"`
+	Name   types.String                                 `tfsdk:"name"`
+}
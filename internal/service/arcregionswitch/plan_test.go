@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfarcregionswitch "github.com/hashicorp/terraform-provider-aws/internal/service/arcregionswitch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCRegionSwitchPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Plan
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arcregionswitch_plan.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCRegionSwitchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(ctx, resourceName, names.AttrARN, "arc-region-switch", regexache.MustCompile(`plan/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "associated_alarm.#", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "primary_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "recovery_approach", string(awstypes.RecoveryApproachActivePassive)),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.workflow_target_action", string(awstypes.WorkflowTargetActionActivate)),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.step.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.step.0.execution_block_type", string(awstypes.ExecutionBlockTypeExecutionApproval)),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.step.0.execution_block_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.step.0.execution_block_configuration.0.execution_approval_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow.0.step.0.execution_block_configuration.0.execution_approval_config.0.approval_role", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccARCRegionSwitchPlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Plan
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arcregionswitch_plan.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCRegionSwitchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfarcregionswitch.ResourcePlan, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccARCRegionSwitchPlan_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Plan
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arcregionswitch_plan.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCRegionSwitchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+			{
+				Config: testAccPlanConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPlanConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccARCRegionSwitchPlan_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Plan
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arcregionswitch_plan.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCRegionSwitchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "1"),
+				),
+			},
+			{
+				Config: testAccPlanConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "associated_alarm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "recovery_time_objective_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.condition.0.associated_alarm_name", "trigger-alarm"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.1.workflow_target_action", string(awstypes.WorkflowTargetActionDeactivate)),
+					resource.TestCheckResourceAttr(resourceName, "workflow.1.step.0.execution_block_type", string(awstypes.ExecutionBlockTypeCustomActionLambda)),
+					resource.TestCheckResourceAttr(resourceName, "workflow.1.step.0.execution_block_configuration.0.custom_action_lambda_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.1.step.0.execution_block_configuration.0.custom_action_lambda_config.0.lambda.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func testAccCheckPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ARCRegionSwitchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_arcregionswitch_plan" {
+				continue
+			}
+
+			_, err := tfarcregionswitch.FindPlanByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ARC Region Switch Plan %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPlanExists(ctx context.Context, n string, v *awstypes.Plan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ARCRegionSwitchClient(ctx)
+
+		output, err := tfarcregionswitch.FindPlanByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPlanConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "arc-region-switch.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPlanConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_arcregionswitch_plan" "test" {
+  name              = %[1]q
+  execution_role    = aws_iam_role.test.arn
+  recovery_approach = "activePassive"
+  regions           = [%[2]q, %[3]q]
+  primary_region    = %[2]q
+
+  workflow {
+    workflow_target_action = "activate"
+
+    step {
+      name                 = "approval"
+      execution_block_type = "ManualApproval"
+
+      execution_block_configuration {
+        execution_approval_config {
+          approval_role   = aws_iam_role.test.arn
+          timeout_minutes = 60
+        }
+      }
+    }
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion()))
+}
+
+func testAccPlanConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_arcregionswitch_plan" "test" {
+  name                            = %[1]q
+  description                     = "updated"
+  execution_role                  = aws_iam_role.test.arn
+  recovery_approach               = "activePassive"
+  recovery_time_objective_minutes = 30
+  regions                         = [%[2]q, %[3]q]
+  primary_region                  = %[2]q
+
+  associated_alarm {
+    name                = "trigger-alarm"
+    alarm_type          = "trigger"
+    resource_identifier = aws_cloudwatch_metric_alarm.test.arn
+  }
+
+  trigger {
+    action                               = "activate"
+    target_region                        = %[3]q
+    min_delay_minutes_between_executions = 30
+
+    condition {
+      associated_alarm_name = "trigger-alarm"
+      condition             = "red"
+    }
+  }
+
+  workflow {
+    workflow_target_action = "activate"
+
+    step {
+      name                 = "approval"
+      execution_block_type = "ManualApproval"
+
+      execution_block_configuration {
+        execution_approval_config {
+          approval_role   = aws_iam_role.test.arn
+          timeout_minutes = 60
+        }
+      }
+    }
+  }
+
+  workflow {
+    workflow_target_action = "deactivate"
+
+    step {
+      name                 = "lambda"
+      execution_block_type = "CustomActionLambda"
+
+      execution_block_configuration {
+        custom_action_lambda_config {
+          region_to_run          = "deactivatingRegion"
+          retry_interval_minutes = 1
+          timeout_minutes        = 10
+
+          lambda {
+            arn = aws_lambda_function.test.arn
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion()))
+}
+
+func testAccPlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_arcregionswitch_plan" "test" {
+  name              = %[1]q
+  execution_role    = aws_iam_role.test.arn
+  recovery_approach = "activePassive"
+  regions           = [%[2]q, %[3]q]
+  primary_region    = %[2]q
+
+  workflow {
+    workflow_target_action = "activate"
+
+    step {
+      name                 = "approval"
+      execution_block_type = "ManualApproval"
+
+      execution_block_configuration {
+        execution_approval_config {
+          approval_role = aws_iam_role.test.arn
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion(), tagKey1, tagValue1))
+}
+
+func testAccPlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_arcregionswitch_plan" "test" {
+  name              = %[1]q
+  execution_role    = aws_iam_role.test.arn
+  recovery_approach = "activePassive"
+  regions           = [%[2]q, %[3]q]
+  primary_region    = %[2]q
+
+  workflow {
+    workflow_target_action = "activate"
+
+    step {
+      name                 = "approval"
+      execution_block_type = "ManualApproval"
+
+      execution_block_configuration {
+        execution_approval_config {
+          approval_role = aws_iam_role.test.arn
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	planExecutionPollInterval     = 30 * time.Second
+	planExecutionProgressInterval = 2 * time.Minute
+)
+
+// @Action(aws_arcregionswitch_start_plan_execution, name="Start Plan Execution")
+func newStartPlanExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startPlanExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startPlanExecutionAction)(nil)
+)
+
+type startPlanExecutionAction struct {
+	framework.ActionWithModel[startPlanExecutionActionModel]
+}
+
+type startPlanExecutionActionModel struct {
+	framework.WithRegionModel
+	Action        fwtypes.StringEnum[awstypes.ExecutionAction] `tfsdk:"action"`
+	Comment       types.String                                 `tfsdk:"comment"`
+	LatestVersion types.String                                 `tfsdk:"latest_version"`
+	Mode          fwtypes.StringEnum[awstypes.ExecutionMode]   `tfsdk:"mode"`
+	PlanARN       fwtypes.ARN                                  `tfsdk:"plan_arn"`
+	TargetRegion  types.String                                 `tfsdk:"target_region"`
+	Timeout       types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startPlanExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an ARC Region switch plan execution and waits for it to complete. Plan executions that require manual approval wait until the approval is given or the timeout is reached.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ExecutionAction](),
+				Description: "The plan execution action to take. Valid values are activate and deactivate.",
+				Required:    true,
+			},
+			names.AttrComment: schema.StringAttribute{
+				Description: "A comment recorded with the plan execution.",
+				Optional:    true,
+			},
+			"latest_version": schema.StringAttribute{
+				Description: "The version of the plan to execute. Used to ensure that the expected version of the plan is run.",
+				Optional:    true,
+			},
+			names.AttrMode: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ExecutionMode](),
+				Description: "The plan execution mode. Valid values are graceful and ungraceful. Defaults to graceful.",
+				Optional:    true,
+			},
+			"plan_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the Region switch plan to execute.",
+				Required:    true,
+			},
+			"target_region": schema.StringAttribute{
+				Description: "The Region to target with the plan execution action.",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait for the plan execution to complete. Defaults to 3600 seconds (1 hour).",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startPlanExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startPlanExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ARCRegionSwitchClient(ctx)
+	// Plan executions are data plane operations and are not routed to the control plane Region.
+	optFn := withDataPlaneRegion(a.Meta().Region(ctx))
+
+	planARN := config.PlanARN.ValueString()
+	targetRegion := config.TargetRegion.ValueString()
+
+	timeout := 1 * time.Hour
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ARC Region switch plan execution", map[string]any{
+		"plan_arn":        planARN,
+		"target_region":   targetRegion,
+		names.AttrAction:  config.Action.ValueString(),
+		"timeout_seconds": int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting %s execution of plan %s in %s...", config.Action.ValueString(), planARN, targetRegion),
+	})
+
+	var input arcregionswitch.StartPlanExecutionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartPlanExecution(ctx, &input, optFn)
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting ARC Region Switch Plan (%s) execution", planARN), err.Error())
+
+		return
+	}
+
+	executionID := aws.ToString(output.ExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Plan execution %s started, waiting for completion...", executionID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*arcregionswitch.GetPlanExecutionOutput], error) {
+		input := arcregionswitch.GetPlanExecutionInput{
+			ExecutionId: aws.String(executionID),
+			PlanArn:     aws.String(planARN),
+		}
+		output, err := conn.GetPlanExecution(ctx, &input, optFn)
+
+		if err != nil {
+			return actionwait.FetchResult[*arcregionswitch.GetPlanExecutionOutput]{}, err
+		}
+
+		return actionwait.FetchResult[*arcregionswitch.GetPlanExecutionOutput]{Status: actionwait.Status(output.ExecutionState), Value: output}, nil
+	}, actionwait.Options[*arcregionswitch.GetPlanExecutionOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(planExecutionPollInterval),
+		ProgressInterval: planExecutionProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStateCompleted),
+			actionwait.Status(awstypes.ExecutionStateCompletedMonitoringApplicationHealth),
+			actionwait.Status(awstypes.ExecutionStateCompletedWithExceptions),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatePending),
+			actionwait.Status(awstypes.ExecutionStateInProgress),
+			actionwait.Status(awstypes.ExecutionStatePendingManualApproval),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStateCancelled),
+			actionwait.Status(awstypes.ExecutionStateFailed),
+			actionwait.Status(awstypes.ExecutionStatePausedByFailedStep),
+			actionwait.Status(awstypes.ExecutionStatePausedByOperator),
+			actionwait.Status(awstypes.ExecutionStatePlanExecutionTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Plan execution %s currently in state: %s", executionID, fr.Status),
+			})
+		},
+	})
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Timeout waiting for ARC Region Switch Plan execution",
+				fmt.Sprintf("Plan execution %s did not complete within %s: %s", executionID, timeout, err))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("ARC Region Switch Plan execution failed",
+				fmt.Sprintf("Plan execution %s: %s", executionID, err))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected ARC Region Switch Plan execution state",
+				fmt.Sprintf("Plan execution %s: %s", executionID, err))
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for ARC Region Switch Plan (%s) execution (%s)", planARN, executionID), err.Error())
+		}
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Plan execution %s finished with state: %s", executionID, fr.Status),
+	})
+
+	tflog.Info(ctx, "ARC Region switch plan execution finished", map[string]any{
+		"plan_arn":        planARN,
+		"execution_id":    executionID,
+		"execution_state": string(fr.Status),
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arcregionswitch/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCRegionSwitchStartPlanExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arcregionswitch_plan.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCRegionSwitchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartPlanExecutionActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExecutionCompleted(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPlanExecutionCompleted(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ARCRegionSwitchClient(ctx)
+
+		input := arcregionswitch.ListPlanExecutionsInput{
+			PlanArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+		}
+		output, err := conn.ListPlanExecutions(ctx, &input, func(o *arcregionswitch.Options) {
+			o.Region = acctest.Region()
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Items) == 0 {
+			return fmt.Errorf("no executions found for ARC Region Switch Plan %s", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		if state := output.Items[0].ExecutionState; state != awstypes.ExecutionStateCompleted {
+			return fmt.Errorf("ARC Region Switch Plan %s execution state: %s", rs.Primary.Attributes[names.AttrARN], state)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartPlanExecutionActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "lambda:InvokeFunction"
+      Resource = aws_lambda_function.test.arn
+    }]
+  })
+}
+
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_arcregionswitch_plan" "test" {
+  name              = %[1]q
+  execution_role    = aws_iam_role.test.arn
+  recovery_approach = "activePassive"
+  regions           = [%[2]q, %[3]q]
+  primary_region    = %[2]q
+
+  workflow {
+    workflow_target_action = "activate"
+
+    step {
+      name                 = "lambda"
+      execution_block_type = "CustomActionLambda"
+
+      execution_block_configuration {
+        custom_action_lambda_config {
+          region_to_run          = "activatingRegion"
+          retry_interval_minutes = 1
+          timeout_minutes        = 10
+
+          lambda {
+            arn = aws_lambda_function.test.arn
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+action "aws_arcregionswitch_start_plan_execution" "test" {
+  config {
+    plan_arn      = aws_arcregionswitch_plan.test.arn
+    target_region = %[2]q
+    action        = "activate"
+    timeout       = 1800
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_arcregionswitch_plan.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_arcregionswitch_start_plan_execution.test]
+    }
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion()))
+}
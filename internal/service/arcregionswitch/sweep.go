@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package arcregionswitch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_arcregionswitch_plan", sweepPlans)
+}
+
+func sweepPlans(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ARCRegionSwitchClient(ctx)
+	var input arcregionswitch.ListPlansInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for v, err := range listPlans(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		sweepResources = append(sweepResources, framework.NewSweepResource(newPlanResource, client,
+			framework.NewAttribute(names.AttrARN, aws.ToString(v.Arn)),
+		))
+	}
+
+	return sweepResources, nil
+}
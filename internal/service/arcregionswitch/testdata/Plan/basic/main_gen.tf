@@ -0,0 +1,54 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_arcregionswitch_plan" "test" {
+  name              = var.rName
+  execution_role    = aws_iam_role.test.arn
+  recovery_approach = "activePassive"
+  regions           = [data.aws_region.current.region, local.alternate_region]
+  primary_region    = data.aws_region.current.region
+
+  workflow {
+    workflow_target_action = "activate"
+
+    step {
+      name                 = "approval"
+      execution_block_type = "ManualApproval"
+
+      execution_block_configuration {
+        execution_approval_config {
+          approval_role = aws_iam_role.test.arn
+        }
+      }
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "arc-region-switch.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+data "aws_region" "current" {}
+
+data "aws_regions" "available" {}
+
+locals {
+  alternate_region = [for r in sort(data.aws_regions.available.names) : r if r != data.aws_region.current.region][0]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
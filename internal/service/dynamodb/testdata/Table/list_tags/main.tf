@@ -0,0 +1,46 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_dynamodb_table" "expected" {
+  count = 2
+
+  hash_key       = "TestTableHashKey"
+  name           = "${var.rName}-expected-${count.index}"
+  read_capacity  = 1
+  write_capacity = 1
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags = {
+    (var.rName) = "expected"
+  }
+}
+
+resource "aws_dynamodb_table" "not_expected" {
+  count = 1
+
+  hash_key       = "TestTableHashKey"
+  name           = "${var.rName}-not-expected-${count.index}"
+  read_capacity  = 1
+  write_capacity = 1
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags = {
+    (var.rName) = "not-expected"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
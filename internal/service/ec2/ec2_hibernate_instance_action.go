@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_hibernate_instance, name="Hibernate Instance")
+func newHibernateInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &hibernateInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*hibernateInstanceAction)(nil)
+)
+
+type hibernateInstanceAction struct {
+	framework.ActionWithModel[instanceLifecycleActionModel]
+}
+
+func (a *hibernateInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Hibernates one or more EC2 instances. The instances must have been launched with hibernation enabled. This action will hibernate the instances and wait for each of them to reach the stopped state.",
+		Attributes:  instanceLifecycleActionSchemaAttributes("hibernate"),
+	}
+}
+
+func (a *hibernateInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceLifecycleActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceIDs := fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs)
+	timeout := instanceLifecycleActionTimeout(config)
+
+	tflog.Info(ctx, "Starting EC2 hibernate instance action", map[string]any{
+		"instance_ids":    instanceIDs,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting hibernate operation for %d EC2 instance(s)...", len(instanceIDs)),
+	})
+
+	// Check current instance states and hibernation support first
+	var toHibernate, toWait []string
+	for _, instanceID := range instanceIDs {
+		instance, err := findInstanceByID(ctx, conn, instanceID)
+		if err != nil {
+			addInstanceLookupError(resp, instanceID, err)
+			return
+		}
+
+		if instance.HibernationOptions == nil || !aws.ToBool(instance.HibernationOptions.Configured) {
+			resp.Diagnostics.AddError(
+				"Hibernation Not Configured",
+				fmt.Sprintf("EC2 instance %s was not launched with hibernation enabled", instanceID),
+			)
+			return
+		}
+
+		switch state := instance.State.Name; state {
+		case awstypes.InstanceStateNameStopped:
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("EC2 instance %s is already stopped", instanceID),
+			})
+		case awstypes.InstanceStateNameStopping:
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("EC2 instance %s is already stopping, waiting for completion...", instanceID),
+			})
+			toWait = append(toWait, instanceID)
+		case awstypes.InstanceStateNameRunning:
+			toHibernate = append(toHibernate, instanceID)
+			toWait = append(toWait, instanceID)
+		default:
+			resp.Diagnostics.AddError(
+				"Cannot Hibernate Instance",
+				fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be hibernated. Instance must be in 'running' or 'stopping' state.", instanceID, state),
+			)
+			return
+		}
+	}
+
+	if len(toHibernate) > 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Sending hibernate command to EC2 instance(s) %v...", toHibernate),
+		})
+
+		input := ec2.StopInstancesInput{
+			Hibernate:   aws.Bool(true),
+			InstanceIds: toHibernate,
+		}
+
+		_, err := conn.StopInstances(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Hibernate Instances",
+				fmt.Sprintf("Could not hibernate EC2 instance(s) %v: %s", toHibernate, err),
+			)
+			return
+		}
+	}
+
+	if len(toWait) > 0 {
+		fetch := func(ctx context.Context, instanceID string) (actionwait.Status, error) {
+			instance, err := findInstanceByID(ctx, conn, instanceID)
+			if err != nil {
+				return "", fmt.Errorf("describing instance: %w", err)
+			}
+			return actionwait.Status(instance.State.Name), nil
+		}
+
+		if !waitForInstancesStatus(ctx, resp, toWait, "hibernate", timeout, fetch, actionwait.Options[struct{}]{
+			SuccessStates: []actionwait.Status{actionwait.Status(awstypes.InstanceStateNameStopped)},
+			TransitionalStates: []actionwait.Status{
+				actionwait.Status(awstypes.InstanceStateNameRunning),
+				actionwait.Status(awstypes.InstanceStateNameStopping),
+			},
+		}) {
+			return
+		}
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%d EC2 instance(s) have been hibernated", len(instanceIDs)),
+	})
+
+	tflog.Info(ctx, "EC2 hibernate instance action completed successfully", map[string]any{
+		"instance_ids": instanceIDs,
+	})
+}
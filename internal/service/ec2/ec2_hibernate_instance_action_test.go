@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2HibernateInstanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHibernateInstanceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, resourceName, &v),
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+			{
+				PreConfig: func() {
+					if v.InstanceId == nil {
+						t.Fatal("Instance ID is nil")
+					}
+
+					if err := invokeInstanceLifecycleAction(ctx, t, "aws_ec2_hibernate_instance", []string{*v.InstanceId}); err != nil {
+						t.Fatalf("Failed to invoke hibernate instance action: %v", err)
+					}
+				},
+				Config: testAccHibernateInstanceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameStopped),
+				),
+			},
+		},
+	})
+}
+
+func testAccHibernateInstanceActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		fmt.Sprintf(`
+# must be >= m3 and have an encrypted root volume to enable hibernation
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  hibernation   = true
+  instance_type = "m5.large"
+
+  root_block_device {
+    encrypted   = true
+    volume_size = 20
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_hibernate_instance" "test" {
+  config {
+    instance_ids = [aws_instance.test.id]
+  }
+}
+`, rName))
+}
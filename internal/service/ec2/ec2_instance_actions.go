@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Shared plumbing for the multi-instance lifecycle actions
+// (aws_ec2_start_instance, aws_ec2_reboot_instance and aws_ec2_hibernate_instance).
+
+const (
+	// instanceActionPollInterval defines polling cadence for instance lifecycle actions.
+	instanceActionPollInterval = 10 * time.Second
+	// instanceActionDefaultTimeout is the default overall timeout for instance lifecycle actions.
+	instanceActionDefaultTimeout = 600 * time.Second
+)
+
+type instanceLifecycleActionModel struct {
+	framework.WithRegionModel
+	InstanceIDs fwtypes.ListOfString `tfsdk:"instance_ids"`
+	Timeout     types.Int64          `tfsdk:"timeout"`
+}
+
+// instanceLifecycleActionSchemaAttributes returns the attributes shared by the instance lifecycle actions.
+func instanceLifecycleActionSchemaAttributes(operation string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"instance_ids": schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			Description: fmt.Sprintf("The IDs of the EC2 instances to %s", operation),
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.SizeAtMost(1000), // EC2 API limit
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+						"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+					),
+				),
+			},
+		},
+		names.AttrTimeout: schema.Int64Attribute{
+			Description: fmt.Sprintf("Timeout in seconds to wait for all instances to %s (default: 600)", operation),
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(30),
+				int64validator.AtMost(3600),
+			},
+		},
+	}
+}
+
+// instanceLifecycleActionTimeout returns the configured timeout, or the default if not set.
+func instanceLifecycleActionTimeout(config instanceLifecycleActionModel) time.Duration {
+	if !config.Timeout.IsNull() {
+		return time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	return instanceActionDefaultTimeout
+}
+
+// instanceStatusFetchFunc returns the current status of a single instance.
+type instanceStatusFetchFunc func(ctx context.Context, instanceID string) (actionwait.Status, error)
+
+// waitForInstancesStatus waits for each instance in turn to reach one of the success states in opts,
+// sending per-instance progress events. All instances share a single overall deadline.
+// Any error is reported as a diagnostic on resp and false is returned.
+func waitForInstancesStatus(ctx context.Context, resp *action.InvokeResponse, instanceIDs []string, operation string, timeout time.Duration, fetch instanceStatusFetchFunc, opts actionwait.Options[struct{}]) bool {
+	deadline := time.Now().Add(timeout)
+	target := statusesString(opts.SuccessStates)
+
+	for i, instanceID := range instanceIDs {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instances",
+				fmt.Sprintf("EC2 instances did not %s within %s; %d of %d instances completed", operation, timeout, i, len(instanceIDs)),
+			)
+			return false
+		}
+
+		opts.Timeout = remaining
+		opts.Interval = actionwait.FixedInterval(instanceActionPollInterval)
+		opts.ProgressInterval = 30 * time.Second
+		opts.ProgressSink = func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("EC2 instance %s (%d/%d) is currently in state '%s', continuing to wait for '%s'...", instanceID, i+1, len(instanceIDs), fr.Status, target),
+			})
+		}
+
+		_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+			status, err := fetch(ctx, instanceID)
+			if err != nil {
+				return actionwait.FetchResult[struct{}]{}, err
+			}
+			return actionwait.FetchResult[struct{}]{Status: status}, nil
+		}, opts)
+		if err != nil {
+			var timeoutErr *actionwait.TimeoutError
+			var failureErr *actionwait.FailureStateError
+			var unexpectedErr *actionwait.UnexpectedStateError
+			if errors.As(err, &timeoutErr) {
+				resp.Diagnostics.AddError(
+					"Timeout Waiting for Instances",
+					fmt.Sprintf("EC2 instance %s did not reach '%s' within %s: %s", instanceID, target, timeout, err),
+				)
+			} else if errors.As(err, &failureErr) || errors.As(err, &unexpectedErr) {
+				resp.Diagnostics.AddError(
+					"Unexpected Instance State",
+					fmt.Sprintf("EC2 instance %s entered unexpected state while waiting to %s: %s", instanceID, operation, err),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Error Waiting for Instances",
+					fmt.Sprintf("Error while waiting for EC2 instance %s to %s: %s", instanceID, operation, err),
+				)
+			}
+			return false
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("EC2 instance %s (%d/%d) has reached '%s'", instanceID, i+1, len(instanceIDs), target),
+		})
+	}
+
+	return true
+}
+
+// addInstanceLookupError reports an error from looking up an instance prior to an action.
+func addInstanceLookupError(resp *action.InvokeResponse, instanceID string, err error) {
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Instance Not Found",
+			fmt.Sprintf("EC2 instance %s was not found", instanceID),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Failed to Describe Instance",
+		fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
+	)
+}
+
+func statusesString(statuses []actionwait.Status) string {
+	return strings.Join(tfslices.ApplyToAll(statuses, func(v actionwait.Status) string {
+		return string(v)
+	}), "' or '")
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_reboot_instance, name="Reboot Instance")
+func newRebootInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootInstanceAction)(nil)
+)
+
+type rebootInstanceAction struct {
+	framework.ActionWithModel[instanceLifecycleActionModel]
+}
+
+func (a *rebootInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots one or more EC2 instances. This action will request a reboot of the instances and wait for each of them to pass instance status checks. It does not wait for the reboot itself to complete.",
+		Attributes:  instanceLifecycleActionSchemaAttributes("reboot"),
+	}
+}
+
+func (a *rebootInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceLifecycleActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceIDs := fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs)
+	timeout := instanceLifecycleActionTimeout(config)
+
+	tflog.Info(ctx, "Starting EC2 reboot instance action", map[string]any{
+		"instance_ids":    instanceIDs,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting reboot operation for %d EC2 instance(s)...", len(instanceIDs)),
+	})
+
+	// Only running instances can be rebooted
+	for _, instanceID := range instanceIDs {
+		instance, err := findInstanceByID(ctx, conn, instanceID)
+		if err != nil {
+			addInstanceLookupError(resp, instanceID, err)
+			return
+		}
+
+		if state := instance.State.Name; state != awstypes.InstanceStateNameRunning {
+			resp.Diagnostics.AddError(
+				"Cannot Reboot Instance",
+				fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be rebooted. Instance must be in 'running' state.", instanceID, state),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending reboot command to EC2 instance(s) %v...", instanceIDs),
+	})
+
+	input := ec2.RebootInstancesInput{
+		InstanceIds: instanceIDs,
+	}
+
+	_, err := conn.RebootInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot Instances",
+			fmt.Sprintf("Could not reboot EC2 instance(s) %v: %s", instanceIDs, err),
+		)
+		return
+	}
+
+	// A reboot does not change the instance state, its launch time or (usually) its status checks, so there is no
+	// reliable signal that the reboot has completed. Wait only for the instance status checks to pass; an instance
+	// whose status never leaves 'ok' is treated as healthy straight away.
+	// The status reported is the instance state if the instance is not running, otherwise the instance status summary.
+	fetch := func(ctx context.Context, instanceID string) (actionwait.Status, error) {
+		input := ec2.DescribeInstanceStatusInput{
+			InstanceIds:         []string{instanceID},
+			IncludeAllInstances: aws.Bool(true),
+		}
+		status, err := findInstanceStatus(ctx, conn, &input)
+		if err != nil {
+			return "", fmt.Errorf("describing instance status: %w", err)
+		}
+		if status.InstanceState != nil && status.InstanceState.Name != awstypes.InstanceStateNameRunning {
+			return actionwait.Status(status.InstanceState.Name), nil
+		}
+		if status.InstanceStatus == nil {
+			return actionwait.Status(awstypes.SummaryStatusInsufficientData), nil
+		}
+		return actionwait.Status(status.InstanceStatus.Status), nil
+	}
+
+	if !waitForInstancesStatus(ctx, resp, instanceIDs, "reboot", timeout, fetch, actionwait.Options[struct{}]{
+		SuccessStates: []actionwait.Status{actionwait.Status(awstypes.SummaryStatusOk)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceStateNamePending),
+			actionwait.Status(awstypes.SummaryStatusInitializing),
+			actionwait.Status(awstypes.SummaryStatusInsufficientData),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.SummaryStatusImpaired),
+		},
+	}) {
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Reboot requested for %d EC2 instance(s) and instance status checks are ok", len(instanceIDs)),
+	})
+
+	tflog.Info(ctx, "EC2 reboot instance action completed successfully", map[string]any{
+		"instance_ids": instanceIDs,
+	})
+}
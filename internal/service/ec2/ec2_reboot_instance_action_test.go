@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2RebootInstanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRebootInstanceActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, resourceName, &v),
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+			{
+				PreConfig: func() {
+					if v.InstanceId == nil {
+						t.Fatal("Instance ID is nil")
+					}
+
+					if err := invokeInstanceLifecycleAction(ctx, t, "aws_ec2_reboot_instance", []string{*v.InstanceId}); err != nil {
+						t.Fatalf("Failed to invoke reboot instance action: %v", err)
+					}
+				},
+				Config: testAccRebootInstanceActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
+func testAccRebootInstanceActionConfig_trigger(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  count = 2
+
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_reboot_instance" "test" {
+  config {
+    instance_ids = aws_instance.test[*].id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_ec2_reboot_instance.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_start_instance, name="Start Instance")
+func newStartInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceAction)(nil)
+)
+
+type startInstanceAction struct {
+	framework.ActionWithModel[instanceLifecycleActionModel]
+}
+
+func (a *startInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts one or more EC2 instances. This action will start the instances and wait for each of them to reach the running state.",
+		Attributes:  instanceLifecycleActionSchemaAttributes("start"),
+	}
+}
+
+func (a *startInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceLifecycleActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceIDs := fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs)
+	timeout := instanceLifecycleActionTimeout(config)
+
+	tflog.Info(ctx, "Starting EC2 start instance action", map[string]any{
+		"instance_ids":    instanceIDs,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting start operation for %d EC2 instance(s)...", len(instanceIDs)),
+	})
+
+	// Check current instance states first
+	var toStart, toWait []string
+	for _, instanceID := range instanceIDs {
+		instance, err := findInstanceByID(ctx, conn, instanceID)
+		if err != nil {
+			addInstanceLookupError(resp, instanceID, err)
+			return
+		}
+
+		switch state := instance.State.Name; state {
+		case awstypes.InstanceStateNameRunning:
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("EC2 instance %s is already running", instanceID),
+			})
+		case awstypes.InstanceStateNamePending:
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("EC2 instance %s is already starting, waiting for completion...", instanceID),
+			})
+			toWait = append(toWait, instanceID)
+		case awstypes.InstanceStateNameStopped:
+			toStart = append(toStart, instanceID)
+			toWait = append(toWait, instanceID)
+		default:
+			resp.Diagnostics.AddError(
+				"Cannot Start Instance",
+				fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be started. Instance must be in 'stopped' or 'pending' state.", instanceID, state),
+			)
+			return
+		}
+	}
+
+	if len(toStart) > 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Sending start command to EC2 instance(s) %v...", toStart),
+		})
+
+		input := ec2.StartInstancesInput{
+			InstanceIds: toStart,
+		}
+
+		_, err := conn.StartInstances(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Start Instances",
+				fmt.Sprintf("Could not start EC2 instance(s) %v: %s", toStart, err),
+			)
+			return
+		}
+	}
+
+	if len(toWait) > 0 {
+		fetch := func(ctx context.Context, instanceID string) (actionwait.Status, error) {
+			instance, err := findInstanceByID(ctx, conn, instanceID)
+			if err != nil {
+				return "", fmt.Errorf("describing instance: %w", err)
+			}
+			return actionwait.Status(instance.State.Name), nil
+		}
+
+		if !waitForInstancesStatus(ctx, resp, toWait, "start", timeout, fetch, actionwait.Options[struct{}]{
+			SuccessStates: []actionwait.Status{actionwait.Status(awstypes.InstanceStateNameRunning)},
+			TransitionalStates: []actionwait.Status{
+				actionwait.Status(awstypes.InstanceStateNameStopped),
+				actionwait.Status(awstypes.InstanceStateNamePending),
+			},
+		}) {
+			return
+		}
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%d EC2 instance(s) are running", len(instanceIDs)),
+	})
+
+	tflog.Info(ctx, "EC2 start instance action completed successfully", map[string]any{
+		"instance_ids": instanceIDs,
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2StartInstanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, resourceName, &v),
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+			{
+				PreConfig: func() {
+					if v.InstanceId == nil {
+						t.Fatal("Instance ID is nil")
+					}
+
+					if err := invokeStopInstanceAction(ctx, t, *v.InstanceId, true); err != nil {
+						t.Fatalf("Failed to invoke stop instance action: %v", err)
+					}
+				},
+				Config: testAccStartInstanceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameStopped),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := invokeInstanceLifecycleAction(ctx, t, "aws_ec2_start_instance", []string{*v.InstanceId}); err != nil {
+						t.Fatalf("Failed to invoke start instance action: %v", err)
+					}
+				},
+				Config: testAccStartInstanceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2StartInstanceAction_alreadyRunning(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, resourceName, &v),
+					testAccCheckInstanceState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
+func testAccStartInstanceActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_start_instance" "test" {
+  config {
+    instance_ids = [aws_instance.test.id]
+  }
+}
+`, rName))
+}
+
+func testAccStartInstanceActionConfig_trigger(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_start_instance" "test" {
+  config {
+    instance_ids = [aws_instance.test.id]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ec2_start_instance.test]
+    }
+  }
+}
+`, rName))
+}
+
+// invokeInstanceLifecycleAction programmatically invokes one of the multi-instance lifecycle actions
+// (aws_ec2_start_instance, aws_ec2_reboot_instance, aws_ec2_hibernate_instance).
+func invokeInstanceLifecycleAction(ctx context.Context, t *testing.T, actionTypeName string, instanceIDs []string) error {
+	t.Helper()
+
+	p := providerWithActions(ctx, t)
+
+	configType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"instance_ids":    tftypes.List{ElementType: tftypes.String},
+			names.AttrTimeout: tftypes.Number,
+			names.AttrRegion:  tftypes.String,
+		},
+	}
+
+	ids := make([]tftypes.Value, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		ids = append(ids, tftypes.NewValue(tftypes.String, id))
+	}
+
+	configMap := map[string]tftypes.Value{
+		"instance_ids":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, ids),
+		names.AttrTimeout: tftypes.NewValue(tftypes.Number, nil),
+		names.AttrRegion:  tftypes.NewValue(tftypes.String, nil),
+	}
+
+	testConfig, err := tfprotov5.NewDynamicValue(
+		configType,
+		tftypes.NewValue(configType, configMap),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create config: %w", err)
+	}
+
+	invokeResp, err := p.InvokeAction(ctx, &tfprotov5.InvokeActionRequest{
+		ActionType: actionTypeName,
+		Config:     &testConfig,
+	})
+	if err != nil {
+		return fmt.Errorf("invoke failed: %w", err)
+	}
+
+	for event := range invokeResp.Events {
+		switch eventType := event.Type.(type) {
+		case tfprotov5.ProgressInvokeActionEventType:
+			t.Logf("Progress: %s", eventType.Message)
+		case tfprotov5.CompletedInvokeActionEventType:
+			return nil
+		default:
+			t.Logf("Received event type: %T", eventType)
+		}
+	}
+
+	return nil
+}
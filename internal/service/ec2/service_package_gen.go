@@ -21,6 +21,24 @@ type servicePackage struct{}
 
 func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
 	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newHibernateInstanceAction,
+			TypeName: "aws_ec2_hibernate_instance",
+			Name:     "Hibernate Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newRebootInstanceAction,
+			TypeName: "aws_ec2_reboot_instance",
+			Name:     "Reboot Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newStartInstanceAction,
+			TypeName: "aws_ec2_start_instance",
+			Name:     "Start Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newStopInstanceAction,
 			TypeName: "aws_ec2_stop_instance",
@@ -1867,6 +1885,16 @@ func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttype
 			}),
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
 		},
+		{
+			Factory:  securityGroupResourceAsListResource,
+			TypeName: "aws_security_group",
+			Name:     "Security Group",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			}),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
+		},
 		{
 			Factory:  subnetResourceAsListResource,
 			TypeName: "aws_subnet",
@@ -25,6 +25,10 @@ import (
 )
 
 func RegisterSweepers() {
+	// Network interfaces are swept before subnets, which are swept before VPCs.
+	sweep.RegisterResourceTypeDependencies("aws_network_interface", "aws_subnet")
+	sweep.RegisterResourceTypeDependencies("aws_subnet", "aws_vpc")
+
 	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
@@ -154,7 +158,7 @@ func RegisterSweepers() {
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -260,7 +264,6 @@ func RegisterSweepers() {
 		"aws_memorydb_subnet_group",
 		"aws_mq_broker",
 		"aws_msk_cluster",
-		"aws_network_interface",
 		"aws_networkfirewall_firewall",
 		"aws_opensearch_domain",
 		"aws_quicksight_vpc_connection",
@@ -372,7 +375,7 @@ func RegisterSweepers() {
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -384,7 +387,6 @@ func RegisterSweepers() {
 			"aws_vpc_route_server_vpc_association",
 			"aws_route_table",
 			"aws_security_group",
-			"aws_subnet",
 			"aws_vpc_peering_connection",
 			"aws_vpn_gateway",
 			"aws_vpclattice_service_network",
@@ -727,7 +729,10 @@ func sweepEBSVolumes(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				CreationTime: aws.ToTime(v.CreateTime),
+				Tags:         keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
@@ -770,7 +775,10 @@ func sweepEBSSnapshots(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.SnapshotId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				CreationTime: aws.ToTime(v.StartTime),
+				Tags:         keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
@@ -1029,15 +1037,22 @@ func sweepInstances(region string) error {
 					continue
 				}
 
-				if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
-					log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+				metadata := sweep.Metadata{
+					CreationTime: aws.ToTime(v.LaunchTime),
+					Tags:         keyValueTags(ctx, v.Tags).Map(),
+				}
+
+				if sweep.Allowed(ctx, metadata) {
+					if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
+						log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+					}
 				}
 
 				r := resourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), metadata))
 			}
 		}
 	}
@@ -1157,7 +1172,10 @@ func sweepKeyPairs(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.ToString(v.KeyName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+			CreationTime: aws.ToTime(v.CreateTime),
+			Tags:         keyValueTags(ctx, v.Tags).Map(),
+		}))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
@@ -1197,7 +1215,10 @@ func sweepLaunchTemplates(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.LaunchTemplateId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				CreationTime: aws.ToTime(v.CreateTime),
+				Tags:         keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
@@ -1238,7 +1259,10 @@ func sweepNATGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.NatGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				CreationTime: aws.ToTime(v.CreateTime),
+				Tags:         keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
@@ -1331,7 +1355,7 @@ func sweepNetworkInterfaces(region string) error {
 			id := aws.ToString(v.NetworkInterfaceId)
 
 			if v.Status != awstypes.NetworkInterfaceStatusAvailable {
-				log.Printf("[INFO] Skipping EC2 Network Interface (%s): %s", v.Status, id)
+				sweep.Skip(ctx, id, fmt.Sprintf("status is %s", v.Status))
 				continue
 			}
 
@@ -1339,7 +1363,9 @@ func sweepNetworkInterfaces(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithResourceType("aws_network_interface", sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Tags: keyValueTags(ctx, v.TagSet).Map(),
+			})))
 		}
 	}
 
@@ -1610,6 +1636,10 @@ func sweepSecurityGroups(region string) error {
 				continue
 			}
 
+			if !sweep.Allowed(ctx, securityGroupSweepMetadata(ctx, sg)) {
+				continue
+			}
+
 			if sg.IpPermissions != nil {
 				input := ec2.RevokeSecurityGroupIngressInput{
 					GroupId:       sg.GroupId,
@@ -1653,6 +1683,11 @@ func sweepSecurityGroups(region string) error {
 				continue
 			}
 
+			if !sweep.Allowed(ctx, securityGroupSweepMetadata(ctx, sg)) {
+				sweep.Skip(ctx, aws.ToString(sg.GroupId), "excluded by sweep filter")
+				continue
+			}
+
 			input := ec2.DeleteSecurityGroupInput{
 				GroupId: sg.GroupId,
 			}
@@ -1679,6 +1714,15 @@ func sweepSecurityGroups(region string) error {
 	return nil
 }
 
+// securityGroupSweepMetadata returns the sweep filter metadata of the specified security group.
+// Security groups have no creation time.
+func securityGroupSweepMetadata(ctx context.Context, sg awstypes.SecurityGroup) sweep.Metadata {
+	return sweep.Metadata{
+		ARN:  aws.ToString(sg.SecurityGroupArn),
+		Tags: keyValueTags(ctx, sg.Tags).Map(),
+	}
+}
+
 func sweepSpotFleetRequests(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -1802,7 +1846,10 @@ func sweepSubnets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.SubnetId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				ARN:  aws.ToString(v.SubnetArn),
+				Tags: keyValueTags(ctx, v.Tags).Map(),
+			}))
 		}
 	}
 
@@ -2502,7 +2549,9 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.VpcId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithResourceType("aws_vpc", sweep.WithMetadata(sweep.NewSweepResource(r, d, client), sweep.Metadata{
+				Tags: keyValueTags(ctx, v.Tags).Map(),
+			})))
 		}
 	}
 
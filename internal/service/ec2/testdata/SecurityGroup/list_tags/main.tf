@@ -0,0 +1,36 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_security_group" "expected" {
+  count = 2
+
+  name   = "${var.rName}-expected-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    (var.rName) = "expected"
+  }
+}
+
+resource "aws_security_group" "not_expected" {
+  count = 2
+
+  name   = "${var.rName}-not-expected-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    (var.rName) = "not-expected"
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// runTaskPollInterval defines polling cadence for the run task action.
+	runTaskPollInterval = 10 * time.Second
+	// runTaskDefaultTimeout is the default overall timeout for the run task action.
+	runTaskDefaultTimeout = 30 * time.Minute
+)
+
+// @Action(aws_ecs_run_task, name="Run Task")
+func newRunTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &runTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*runTaskAction)(nil)
+)
+
+type runTaskAction struct {
+	framework.ActionWithModel[runTaskActionModel]
+}
+
+type runTaskActionModel struct {
+	framework.WithRegionModel
+	ClusterName          types.String                                                      `tfsdk:"cluster_name"`
+	ContainerOverrides   fwtypes.ListNestedObjectValueOf[runTaskContainerOverrideModel]    `tfsdk:"container_overrides"`
+	Group                types.String                                                      `tfsdk:"group"`
+	LaunchType           fwtypes.StringEnum[awstypes.LaunchType]                           `tfsdk:"launch_type"`
+	NetworkConfiguration fwtypes.ListNestedObjectValueOf[runTaskNetworkConfigurationModel] `tfsdk:"network_configuration"`
+	PlatformVersion      types.String                                                      `tfsdk:"platform_version"`
+	StartedBy            types.String                                                      `tfsdk:"started_by"`
+	TaskDefinition       types.String                                                      `tfsdk:"task_definition"`
+	Timeout              types.Int64                                                       `tfsdk:"timeout"`
+}
+
+type runTaskContainerOverrideModel struct {
+	Command     fwtypes.ListOfString `tfsdk:"command"`
+	Environment fwtypes.MapOfString  `tfsdk:"environment"`
+	Name        types.String         `tfsdk:"name"`
+}
+
+type runTaskNetworkConfigurationModel struct {
+	AssignPublicIP types.Bool          `tfsdk:"assign_public_ip"`
+	SecurityGroups fwtypes.SetOfString `tfsdk:"security_groups"`
+	Subnets        fwtypes.SetOfString `tfsdk:"subnets"`
+}
+
+func (a *runTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a single ECS task and waits for it to stop. The action fails if any essential container exits with a non-zero exit code.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster to run the task on",
+				Required:    true,
+			},
+			"group": schema.StringAttribute{
+				Description: "Name of the task group to associate with the task",
+				Optional:    true,
+			},
+			"launch_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LaunchType](),
+				Description: "Launch type on which to run the task",
+				Optional:    true,
+			},
+			"platform_version": schema.StringAttribute{
+				Description: "Platform version the task uses. Only applies to the FARGATE launch type",
+				Optional:    true,
+			},
+			"started_by": schema.StringAttribute{
+				Description: "Optional tag specified when the task is started",
+				Optional:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family and revision (family:revision) or full ARN of the task definition to run. If a revision isn't specified, the latest ACTIVE revision is used",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task to stop (default: 1800). The task is stopped if it is still running when the timeout is reached",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"container_overrides": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskContainerOverrideModel](ctx),
+				Description: "Container overrides for the task",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Command to send to the container that overrides the default command from the Docker image or the task definition",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrEnvironment: schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							Description: "Environment variables to send to the container",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Description: "Name of the container that receives the override",
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[runTaskNetworkConfigurationModel](ctx),
+				Description: "Network configuration for the task. Required for task definitions that use the awsvpc network mode",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"assign_public_ip": schema.BoolAttribute{
+							Description: "Whether the task's elastic network interface receives a public IP address",
+							Optional:    true,
+						},
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Description: "IDs of the security groups associated with the task",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrSubnets: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Description: "IDs of the subnets associated with the task",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *runTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runTaskActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ECSClient(ctx)
+
+	clusterName := config.ClusterName.ValueString()
+	taskDefinition := config.TaskDefinition.ValueString()
+	timeout := runTaskDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS run task action", map[string]any{
+		names.AttrClusterName: clusterName,
+		"task_definition":     taskDefinition,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running task from task definition %s...", taskDefinition),
+	})
+
+	input := ecs.RunTaskInput{
+		Cluster:         aws.String(clusterName),
+		Count:           aws.Int32(1),
+		Group:           fwflex.StringFromFramework(ctx, config.Group),
+		LaunchType:      config.LaunchType.ValueEnum(),
+		PlatformVersion: fwflex.StringFromFramework(ctx, config.PlatformVersion),
+		StartedBy:       fwflex.StringFromFramework(ctx, config.StartedBy),
+		TaskDefinition:  aws.String(taskDefinition),
+	}
+
+	containerOverrides, diags := config.ContainerOverrides.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(containerOverrides) > 0 {
+		input.Overrides = &awstypes.TaskOverride{}
+		for _, v := range containerOverrides {
+			containerOverride := awstypes.ContainerOverride{
+				Command: fwflex.ExpandFrameworkStringValueList(ctx, v.Command),
+				Name:    fwflex.StringFromFramework(ctx, v.Name),
+			}
+			for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, v.Environment) {
+				containerOverride.Environment = append(containerOverride.Environment, awstypes.KeyValuePair{
+					Name:  aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+			input.Overrides.ContainerOverrides = append(input.Overrides.ContainerOverrides, containerOverride)
+		}
+	}
+
+	networkConfiguration, diags := config.NetworkConfiguration.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if networkConfiguration != nil {
+		assignPublicIP := awstypes.AssignPublicIpDisabled
+		if networkConfiguration.AssignPublicIP.ValueBool() {
+			assignPublicIP = awstypes.AssignPublicIpEnabled
+		}
+		input.NetworkConfiguration = &awstypes.NetworkConfiguration{
+			AwsvpcConfiguration: &awstypes.AwsVpcConfiguration{
+				AssignPublicIp: assignPublicIP,
+				SecurityGroups: fwflex.ExpandFrameworkStringValueSet(ctx, networkConfiguration.SecurityGroups),
+				Subnets:        fwflex.ExpandFrameworkStringValueSet(ctx, networkConfiguration.Subnets),
+			},
+		}
+	}
+
+	output, err := conn.RunTask(ctx, &input)
+	if err == nil && len(output.Failures) > 0 {
+		err = failureError(&output.Failures[0])
+	}
+	if err == nil && len(output.Tasks) == 0 {
+		err = errors.New("no task started")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Run Task",
+			fmt.Sprintf("Could not run task from task definition %s on ECS cluster %s: %s", taskDefinition, clusterName, err),
+		)
+		return
+	}
+
+	taskARN := aws.ToString(output.Tasks[0].TaskArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s started, waiting for it to stop...", taskARN),
+	})
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Task], error) {
+		task, err := findTaskByTwoPartKey(ctx, conn, taskARN, clusterName)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Task]{}, fmt.Errorf("describing task: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.Task]{Status: actionwait.Status(aws.ToString(task.LastStatus)), Value: task}, nil
+	}, actionwait.Options[*awstypes.Task]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(runTaskPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.DesiredStatusStopped)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Task %s is %s", taskARN, fr.Status),
+			})
+		},
+	})
+	if err != nil {
+		if actionwait.IsTimeout(err) {
+			input := ecs.StopTaskInput{
+				Cluster: aws.String(clusterName),
+				Reason:  aws.String("Timed out waiting for task to stop"),
+				Task:    aws.String(taskARN),
+			}
+			if _, stopErr := conn.StopTask(ctx, &input); stopErr != nil {
+				tflog.Warn(ctx, "Stopping ECS task after timeout", map[string]any{
+					"task_arn": taskARN,
+					"error":    stopErr.Error(),
+				})
+			}
+
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Task",
+				fmt.Sprintf("Task %s did not stop within %s and has been stopped", taskARN, timeout),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Waiting for Task",
+			fmt.Sprintf("Error while waiting for task %s to stop: %s", taskARN, err),
+		)
+		return
+	}
+
+	task := result.Value
+	essential := essentialContainerNames(ctx, conn, aws.ToString(task.TaskDefinitionArn))
+
+	var failures []string
+	for _, container := range task.Containers {
+		name := aws.ToString(container.Name)
+
+		var message string
+		if container.ExitCode != nil {
+			message = fmt.Sprintf("Container %s exited with code %d", name, aws.ToInt32(container.ExitCode))
+		} else {
+			message = fmt.Sprintf("Container %s did not exit normally", name)
+		}
+		if reason := aws.ToString(container.Reason); reason != "" {
+			message += ": " + reason
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: message,
+		})
+
+		// If the task definition can't be read, every container is treated as essential.
+		isEssential := essential == nil
+		if !isEssential {
+			_, isEssential = essential[name]
+		}
+		if isEssential && (container.ExitCode == nil || aws.ToInt32(container.ExitCode) != 0) {
+			failures = append(failures, message)
+		}
+	}
+
+	if len(failures) > 0 {
+		detail := strings.Join(failures, "\n")
+		if reason := aws.ToString(task.StoppedReason); reason != "" {
+			detail = fmt.Sprintf("Task stopped (%s): %s\n%s", task.StopCode, reason, detail)
+		}
+		resp.Diagnostics.AddError(
+			"Task Failed",
+			fmt.Sprintf("Task %s failed:\n%s", taskARN, detail),
+		)
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s completed successfully", taskARN),
+	})
+
+	tflog.Info(ctx, "ECS run task action completed successfully", map[string]any{
+		names.AttrClusterName: clusterName,
+		"task_arn":            taskARN,
+	})
+}
+
+// essentialContainerNames returns the names of the essential containers in the specified task definition.
+// nil is returned if the task definition can't be read.
+func essentialContainerNames(ctx context.Context, conn *ecs.Client, taskDefinitionARN string) map[string]struct{} {
+	input := ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionARN),
+	}
+	taskDefinition, _, err := findTaskDefinition(ctx, conn, &input)
+	if err != nil {
+		tflog.Warn(ctx, "Reading ECS task definition", map[string]any{
+			"task_definition_arn": taskDefinitionARN,
+			"error":               err.Error(),
+		})
+		return nil
+	}
+
+	containerNames := make(map[string]struct{})
+	for _, v := range taskDefinition.ContainerDefinitions {
+		// Containers are essential unless explicitly marked otherwise.
+		if v.Essential == nil || aws.ToBool(v.Essential) {
+			containerNames[aws.ToString(v.Name)] = struct{}{}
+		}
+	}
+
+	return containerNames
+}
+
+func findTask(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTasksInput) (*awstypes.Task, error) {
+	output, err := findTasks(ctx, conn, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findTasks(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTasksInput) ([]awstypes.Task, error) {
+	output, err := conn.DescribeTasks(ctx, input)
+
+	if errs.IsA[*awstypes.ClusterNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Failures {
+		if aws.ToString(v.Reason) == failureReasonMissing {
+			return nil, &retry.NotFoundError{
+				LastError:   failureError(&v),
+				LastRequest: input,
+			}
+		}
+	}
+
+	return output.Tasks, nil
+}
+
+func findTaskByTwoPartKey(ctx context.Context, conn *ecs.Client, taskARN, clusterNameOrARN string) (*awstypes.Task, error) {
+	input := ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   []string{taskARN},
+	}
+
+	return findTask(ctx, conn, &input)
+}
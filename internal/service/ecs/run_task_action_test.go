@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSRunTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskStoppedWithExitCode(ctx, "aws_ecs_cluster.test", rName, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_nonZeroExitCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_basic(rName, 3),
+				ExpectError: regexache.MustCompile(`exited[\s\n]*with[\s\n]*code[\s\n]*3`),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskStoppedWithExitCode(ctx context.Context, n, startedBy string, exitCode int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		listInput := ecs.ListTasksInput{
+			Cluster:       aws.String(rs.Primary.ID),
+			DesiredStatus: awstypes.DesiredStatusStopped,
+			StartedBy:     aws.String(startedBy),
+		}
+		listOutput, err := conn.ListTasks(ctx, &listInput)
+		if err != nil {
+			return err
+		}
+
+		if len(listOutput.TaskArns) != 1 {
+			return fmt.Errorf("expected 1 stopped ECS Task started by %s, got %d", startedBy, len(listOutput.TaskArns))
+		}
+
+		describeInput := ecs.DescribeTasksInput{
+			Cluster: aws.String(rs.Primary.ID),
+			Tasks:   listOutput.TaskArns,
+		}
+		describeOutput, err := conn.DescribeTasks(ctx, &describeInput)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range describeOutput.Tasks {
+			for _, container := range task.Containers {
+				if got := aws.ToInt32(container.ExitCode); container.ExitCode == nil || got != exitCode {
+					return fmt.Errorf("ECS Task %s container %s exit code: got %d, expected %d", aws.ToString(task.TaskArn), aws.ToString(container.Name), got, exitCode)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRunTaskActionConfig_basic(rName string, exitCode int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  subnet_id      = aws_subnet.test[0].id
+  route_table_id = aws_route_table.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    essential = true
+    command   = ["sh", "-c", "exit 0"]
+  }])
+}
+
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster_name    = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.test.arn
+    launch_type     = "FARGATE"
+    started_by      = %[1]q
+
+    container_overrides {
+      name    = "test"
+      command = ["sh", "-c", "echo $MESSAGE; exit %[2]d"]
+
+      environment = {
+        MESSAGE = "hello"
+      }
+    }
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test.id]
+      assign_public_ip = true
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_task_definition.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName, exitCode))
+}
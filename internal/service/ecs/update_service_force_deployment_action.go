@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// forceDeploymentPollInterval defines polling cadence for the force deployment action.
+	forceDeploymentPollInterval = 15 * time.Second
+	// forceDeploymentDefaultTimeout is the default overall timeout for the force deployment action.
+	forceDeploymentDefaultTimeout = 30 * time.Minute
+)
+
+// Non-standard statuses for the force deployment action.
+const (
+	forceDeploymentStatusInProgress = "tfIN_PROGRESS"
+	forceDeploymentStatusFailed     = "tfFAILED"
+	forceDeploymentStatusSteady     = "tfSTEADY"
+)
+
+// @Action(aws_ecs_update_service_force_deployment, name="Update Service Force Deployment")
+func newUpdateServiceForceDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &updateServiceForceDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*updateServiceForceDeploymentAction)(nil)
+)
+
+type updateServiceForceDeploymentAction struct {
+	framework.ActionWithModel[updateServiceForceDeploymentActionModel]
+}
+
+type updateServiceForceDeploymentActionModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+	ServiceName types.String `tfsdk:"service_name"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *updateServiceForceDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a new deployment of an ECS service without changing its configuration and waits for the service to reach a steady state. Running tasks are replaced with tasks that pull the latest image for mutable image tags.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster that hosts the service",
+				Required:    true,
+			},
+			names.AttrServiceName: schema.StringAttribute{
+				Description: "Name or ARN of the ECS service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the service to reach a steady state (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(10800),
+				},
+			},
+		},
+	}
+}
+
+func (a *updateServiceForceDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateServiceForceDeploymentActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ECSClient(ctx)
+
+	clusterName := config.ClusterName.ValueString()
+	serviceName := config.ServiceName.ValueString()
+	timeout := forceDeploymentDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS update service force deployment action", map[string]any{
+		names.AttrClusterName: clusterName,
+		names.AttrServiceName: serviceName,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting new deployment of ECS service %s...", serviceName),
+	})
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(clusterName),
+		ForceNewDeployment: true,
+		Service:            aws.String(serviceName),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Deployment",
+			fmt.Sprintf("Could not force a new deployment of ECS service %s: %s", serviceName, err),
+		)
+		return
+	}
+
+	deployment := findPrimaryTaskSet(output.Service.Deployments)
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Deployment",
+			fmt.Sprintf("No primary deployment found for ECS service %s", serviceName),
+		)
+		return
+	}
+	deploymentID := aws.ToString(deployment.Id)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s started, waiting for ECS service %s to reach a steady state...", deploymentID, serviceName),
+	})
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Service], error) {
+		service, err := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, clusterName)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, fmt.Errorf("describing service: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.Service]{Status: forceDeploymentStatus(service, deploymentID), Value: service}, nil
+	}, actionwait.Options[*awstypes.Service]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(forceDeploymentPollInterval),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{forceDeploymentStatusSteady},
+		TransitionalStates: []actionwait.Status{forceDeploymentStatusInProgress},
+		FailureStates:      []actionwait.Status{forceDeploymentStatusFailed},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if service, ok := fr.Value.(*awstypes.Service); ok && service != nil {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: forceDeploymentProgressMessage(service, deploymentID),
+				})
+			}
+		},
+	})
+	if err != nil {
+		var reason string
+		if service := result.Value; service != nil {
+			if deployment := findDeploymentByID(service.Deployments, deploymentID); deployment != nil {
+				reason = aws.ToString(deployment.RolloutStateReason)
+			}
+		}
+
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		switch {
+		case errors.As(err, &timeoutErr):
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Steady State",
+				fmt.Sprintf("ECS service %s did not reach a steady state within %s", serviceName, timeout),
+			)
+		case errors.As(err, &failureErr):
+			if reason == "" {
+				reason = "deployment was replaced or rolled back"
+			}
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				fmt.Sprintf("Deployment %s of ECS service %s failed: %s", deploymentID, serviceName, reason),
+			)
+		case errors.As(err, &unexpectedErr):
+			resp.Diagnostics.AddError(
+				"Unexpected Service State",
+				fmt.Sprintf("ECS service %s entered an unexpected state: %s", serviceName, err),
+			)
+		default:
+			resp.Diagnostics.AddError(
+				"Error Waiting for Steady State",
+				fmt.Sprintf("Error while waiting for ECS service %s to reach a steady state: %s", serviceName, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("ECS service %s reached a steady state", serviceName),
+	})
+
+	tflog.Info(ctx, "ECS update service force deployment action completed successfully", map[string]any{
+		names.AttrClusterName: clusterName,
+		names.AttrServiceName: serviceName,
+		"deployment_id":       deploymentID,
+	})
+}
+
+// forceDeploymentStatus returns the status of the specified deployment of an ECS service.
+// The service is steady once the deployment is the only one remaining and all of its tasks are running.
+func forceDeploymentStatus(service *awstypes.Service, deploymentID string) actionwait.Status {
+	if aws.ToString(service.Status) != serviceStatusActive {
+		return actionwait.Status(aws.ToString(service.Status))
+	}
+
+	deployment := findDeploymentByID(service.Deployments, deploymentID)
+	if deployment == nil || aws.ToString(deployment.Status) != taskSetStatusPrimary || deployment.RolloutState == awstypes.DeploymentRolloutStateFailed {
+		return forceDeploymentStatusFailed
+	}
+
+	// rolloutState is only returned for services that use the rolling update (ECS) deployment type.
+	if rolloutState := deployment.RolloutState; rolloutState != "" && rolloutState != awstypes.DeploymentRolloutStateCompleted {
+		return forceDeploymentStatusInProgress
+	}
+
+	if len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount {
+		return forceDeploymentStatusSteady
+	}
+
+	return forceDeploymentStatusInProgress
+}
+
+func forceDeploymentProgressMessage(service *awstypes.Service, deploymentID string) string {
+	deployment := findDeploymentByID(service.Deployments, deploymentID)
+	if deployment == nil {
+		return fmt.Sprintf("ECS service %s deployment %s is no longer active", aws.ToString(service.ServiceName), deploymentID)
+	}
+
+	return fmt.Sprintf("ECS service %s deployment %s: %d of %d tasks running, %d pending, %d deployment(s) active",
+		aws.ToString(service.ServiceName), deploymentID, deployment.RunningCount, deployment.DesiredCount, deployment.PendingCount, len(service.Deployments))
+}
+
+func findDeploymentByID(deployments []awstypes.Deployment, id string) *awstypes.Deployment {
+	for _, deployment := range deployments {
+		if aws.ToString(deployment.Id) == id {
+			return &deployment
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSUpdateServiceForceDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	resourceName := "aws_ecs_service.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateServiceForceDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					testAccCheckServiceForceDeployed(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckServiceForceDeployed verifies that more than one deployment of the service has completed
+// and that the service is in a steady state.
+func testAccCheckServiceForceDeployed(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		input := ecs.ListServiceDeploymentsInput{
+			Cluster: aws.String(rs.Primary.Attributes["cluster"]),
+			Service: aws.String(rs.Primary.Attributes[names.AttrName]),
+			Status:  []awstypes.ServiceDeploymentStatus{awstypes.ServiceDeploymentStatusSuccessful},
+		}
+		output, err := conn.ListServiceDeployments(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.ServiceDeployments); got < 2 {
+			return fmt.Errorf("ECS Service %s has %d successful deployments, expected at least 2", rs.Primary.ID, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateServiceForceDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateAndWait(rName, 1, true), `
+action "aws_ecs_update_service_force_deployment" "test" {
+  config {
+    cluster_name = aws_ecs_cluster.test.name
+    service_name = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_service.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_update_service_force_deployment.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,651 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_evs_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/evs/types;awstypes;awstypes.Environment")
+// @Testing(tagsTest=false)
+func newEnvironmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentResource{}
+
+	r.SetDefaultCreateTimeout(6 * time.Hour)
+	r.SetDefaultDeleteTimeout(3 * time.Hour)
+
+	return r, nil
+}
+
+const (
+	// An environment is created with, and must retain, at least 4 hosts.
+	environmentMinHosts = 4
+	environmentMaxHosts = 16
+)
+
+type environmentResource struct {
+	framework.ResourceWithModel[environmentResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *environmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"environment_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckResult](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_access_subnet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"terms_accepted": schema.BoolAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"vcf_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VcfVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"connectivity_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[connectivityInfoModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"private_route_server_peerings": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Required:   true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 2),
+							},
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"host": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[hostInfoForCreateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(environmentMinHosts, environmentMaxHosts),
+				},
+				PlanModifiers: []planmodifier.List{
+					requiresReplaceUnlessImported(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: hostInfoForCreateAttributes(),
+				},
+			},
+			"initial_vlans": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[initialVlansModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					requiresReplaceUnlessImported(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"edge_vtep":        initialVlanInfoBlock(ctx),
+						"expansion_vlan_1": initialVlanInfoBlock(ctx),
+						"expansion_vlan_2": initialVlanInfoBlock(ctx),
+						"hcx":              initialVlanInfoBlock(ctx),
+						"nsx_uplink":       initialVlanInfoBlock(ctx),
+						"vm_management":    initialVlanInfoBlock(ctx),
+						"vmk_management":   initialVlanInfoBlock(ctx),
+						"vmotion":          initialVlanInfoBlock(ctx),
+						"vsan":             initialVlanInfoBlock(ctx),
+						"vtep":             initialVlanInfoBlock(ctx),
+					},
+				},
+			},
+			"license_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[licenseInfoModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"solution_key": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+						"vsan_key": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"service_access_security_groups": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[serviceAccessSecurityGroupsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"vcf_hostnames": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vcfHostnamesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_builder": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_edge_1": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_edge_2": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_1": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_2": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_3": schema.StringAttribute{
+							Required: true,
+						},
+						"sddc_manager": schema.StringAttribute{
+							Required: true,
+						},
+						"vcenter": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func initialVlanInfoBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[initialVlanInfoModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr": schema.StringAttribute{
+					CustomType: fwtypes.CIDRBlockType,
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func hostInfoForCreateAttributes(planModifiers ...planmodifier.String) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dedicated_host_id": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: planModifiers,
+		},
+		"host_name": schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: planModifiers,
+		},
+		names.AttrInstanceType: schema.StringAttribute{
+			CustomType:    fwtypes.StringEnumType[awstypes.InstanceType](),
+			Required:      true,
+			PlanModifiers: planModifiers,
+		},
+		"key_name": schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: planModifiers,
+		},
+		"placement_group_id": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: planModifiers,
+		},
+	}
+}
+
+// requiresReplaceUnlessImported forces replacement when a create-only block changes.
+// GetEnvironment doesn't return the block, so after import the prior state value is null
+// and the configured value is recorded in state without replacing the environment.
+func requiresReplaceUnlessImported() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			response.RequiresReplace = !request.StateValue.IsNull()
+		},
+		"Changing this block requires replacement unless the environment was imported",
+		"Changing this block requires replacement unless the environment was imported",
+	)
+}
+
+func (r *environmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	var input evs.CreateEnvironmentInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateEnvironment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Elastic VMware Environment", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	environmentID := aws.ToString(output.Environment.EnvironmentId)
+	data.ID = fwflex.StringValueToFramework(ctx, environmentID)
+
+	environment, err := waitEnvironmentCreated(ctx, conn, environmentID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Elastic VMware Environment (%s) create", environmentID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, environment, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *environmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findEnvironmentByID(ctx, conn, environmentID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Elastic VMware Environment (%s)", environmentID), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *environmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := evs.DeleteEnvironmentInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		EnvironmentId: aws.String(environmentID),
+	}
+	_, err := conn.DeleteEnvironment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Elastic VMware Environment (%s)", environmentID), err.Error())
+
+		return
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, environmentID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Elastic VMware Environment (%s) delete", environmentID), err.Error())
+
+		return
+	}
+}
+
+func findEnvironmentByID(ctx context.Context, conn *evs.Client, id string) (*awstypes.Environment, error) {
+	input := evs.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+	output, err := findEnvironment(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.EnvironmentState; state == awstypes.EnvironmentStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findEnvironment(ctx context.Context, conn *evs.Client, input *evs.GetEnvironmentInput) (*awstypes.Environment, error) {
+	output, err := conn.GetEnvironment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Environment, nil
+}
+
+func listEnvironments(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentsInput) iter.Seq2[awstypes.EnvironmentSummary, error] {
+	return func(yield func(awstypes.EnvironmentSummary, error) bool) {
+		pages := evs.NewListEnvironmentsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.EnvironmentSummary{}, fmt.Errorf("listing Elastic VMware Environments: %w", err))
+				return
+			}
+
+			for _, v := range page.EnvironmentSummaries {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func statusEnvironment(ctx context.Context, conn *evs.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.EnvironmentState), nil
+	}
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentStateCreating),
+		Target:       enum.Slice(awstypes.EnvironmentStateCreated),
+		Refresh:      statusEnvironment(ctx, conn, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentStateCreated, awstypes.EnvironmentStateCreateFailed, awstypes.EnvironmentStateDeleting),
+		Target:       []string{},
+		Refresh:      statusEnvironment(ctx, conn, id),
+		Timeout:      timeout,
+		Delay:        5 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type environmentResourceModel struct {
+	framework.WithRegionModel
+	ConnectivityInfo            fwtypes.ListNestedObjectValueOf[connectivityInfoModel]            `tfsdk:"connectivity_info"`
+	EnvironmentARN              types.String                                                      `tfsdk:"arn"`
+	EnvironmentName             types.String                                                      `tfsdk:"environment_name"`
+	EnvironmentState            fwtypes.StringEnum[awstypes.EnvironmentState]                     `tfsdk:"environment_state"`
+	EnvironmentStatus           fwtypes.StringEnum[awstypes.CheckResult]                          `tfsdk:"environment_status"`
+	Hosts                       fwtypes.ListNestedObjectValueOf[hostInfoForCreateModel]           `tfsdk:"host"`
+	ID                          types.String                                                      `tfsdk:"id"`
+	InitialVlans                fwtypes.ListNestedObjectValueOf[initialVlansModel]                `tfsdk:"initial_vlans"`
+	KMSKeyID                    types.String                                                      `tfsdk:"kms_key_id"`
+	LicenseInfo                 fwtypes.ListNestedObjectValueOf[licenseInfoModel]                 `tfsdk:"license_info"`
+	ServiceAccessSecurityGroups fwtypes.ListNestedObjectValueOf[serviceAccessSecurityGroupsModel] `tfsdk:"service_access_security_groups"`
+	ServiceAccessSubnetID       types.String                                                      `tfsdk:"service_access_subnet_id"`
+	SiteID                      types.String                                                      `tfsdk:"site_id"`
+	Tags                        tftags.Map                                                        `tfsdk:"tags"`
+	TagsAll                     tftags.Map                                                        `tfsdk:"tags_all"`
+	TermsAccepted               types.Bool                                                        `tfsdk:"terms_accepted"`
+	Timeouts                    timeouts.Value                                                    `tfsdk:"timeouts"`
+	VCFHostnames                fwtypes.ListNestedObjectValueOf[vcfHostnamesModel]                `tfsdk:"vcf_hostnames"`
+	VCFVersion                  fwtypes.StringEnum[awstypes.VcfVersion]                           `tfsdk:"vcf_version"`
+	VPCID                       types.String                                                      `tfsdk:"vpc_id"`
+}
+
+type connectivityInfoModel struct {
+	PrivateRouteServerPeerings fwtypes.SetOfString `tfsdk:"private_route_server_peerings"`
+}
+
+type hostInfoForCreateModel struct {
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+}
+
+type initialVlansModel struct {
+	EdgeVTep       fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"edge_vtep"`
+	ExpansionVlan1 fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"expansion_vlan_1"`
+	ExpansionVlan2 fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"expansion_vlan_2"`
+	Hcx            fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"hcx"`
+	NsxUplink      fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"nsx_uplink"`
+	VmManagement   fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vm_management"`
+	VmkManagement  fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vmk_management"`
+	VMotion        fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vmotion"`
+	VSan           fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vsan"`
+	VTep           fwtypes.ListNestedObjectValueOf[initialVlanInfoModel] `tfsdk:"vtep"`
+}
+
+type initialVlanInfoModel struct {
+	CIDR fwtypes.CIDRBlock `tfsdk:"cidr"`
+}
+
+type licenseInfoModel struct {
+	SolutionKey types.String `tfsdk:"solution_key"`
+	VSANKey     types.String `tfsdk:"vsan_key"`
+}
+
+type serviceAccessSecurityGroupsModel struct {
+	SecurityGroups fwtypes.SetOfString `tfsdk:"security_groups"`
+}
+
+type vcfHostnamesModel struct {
+	CloudBuilder types.String `tfsdk:"cloud_builder"`
+	NSX          types.String `tfsdk:"nsx"`
+	NSXEdge1     types.String `tfsdk:"nsx_edge_1"`
+	NSXEdge2     types.String `tfsdk:"nsx_edge_2"`
+	NSXManager1  types.String `tfsdk:"nsx_manager_1"`
+	NSXManager2  types.String `tfsdk:"nsx_manager_2"`
+	NSXManager3  types.String `tfsdk:"nsx_manager_3"`
+	SDDCManager  types.String `tfsdk:"sddc_manager"`
+	VCenter      types.String `tfsdk:"vcenter"`
+}
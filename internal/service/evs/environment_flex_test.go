@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestExpandEnvironmentHosts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const instanceType = awstypes.InstanceType("i4i.metal")
+
+	var hosts []*hostInfoForCreateModel
+	for i := range environmentMinHosts {
+		hosts = append(hosts, &hostInfoForCreateModel{
+			DedicatedHostID:  types.StringNull(),
+			HostName:         types.StringValue(fmt.Sprintf("esx%d", i)),
+			InstanceType:     fwtypes.StringEnumValue(instanceType),
+			KeyName:          types.StringValue("test"),
+			PlacementGroupID: types.StringNull(),
+		})
+	}
+
+	data := environmentResourceModel{
+		Hosts: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, hosts),
+	}
+
+	var input evs.CreateEnvironmentInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := len(input.Hosts), environmentMinHosts; got != want {
+		t.Fatalf("len(Hosts) = %d, want %d", got, want)
+	}
+
+	for i, v := range input.Hosts {
+		if got, want := aws.ToString(v.HostName), fmt.Sprintf("esx%d", i); got != want {
+			t.Errorf("Hosts[%d].HostName = %q, want %q", i, got, want)
+		}
+		if got, want := v.InstanceType, instanceType; got != want {
+			t.Errorf("Hosts[%d].InstanceType = %q, want %q", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEVSEnvironmentHost_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarEVSSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSVSANKey)
+	var v awstypes.Host
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment_host.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentHostConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_instance_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_evs_environment.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "host_name", "esx5"),
+					resource.TestCheckResourceAttr(resourceName, "host_state", string(awstypes.HostStateCreated)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "i4i.metal"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrIPAddress),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "environment_id", "host_name"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "host_name",
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironmentHost_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarEVSSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSVSANKey)
+	var v awstypes.Host
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment_host.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentHostDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentHostConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentHostExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfevs.ResourceEnvironmentHost, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentHostDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment_host" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentHostByTwoPartKey(ctx, conn, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["host_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Elastic VMware Environment Host %s still exists", rs.Primary.Attributes["host_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentHostExists(ctx context.Context, n string, v *awstypes.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentHostByTwoPartKey(ctx, conn, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["host_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentHostConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey), `
+resource "aws_evs_environment_host" "test" {
+  environment_id     = aws_evs_environment.test.id
+  host_name          = "esx5"
+  instance_type      = "i4i.metal"
+  key_name           = aws_key_pair.test.key_name
+  placement_group_id = aws_ec2_placement_group.test.placement_group_id
+}
+`)
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Environment creation requires a VCF site ID and license keys which must be provided out-of-band.
+const (
+	envVarEVSSiteID      = "AWS_EVS_SITE_ID"
+	envVarEVSSolutionKey = "AWS_EVS_SOLUTION_KEY"
+	envVarEVSVSANKey     = "AWS_EVS_VSAN_KEY"
+)
+
+func TestAccEVSEnvironment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarEVSSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSVSANKey)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "evs", regexache.MustCompile(`environment/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_info.0.private_route_server_peerings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "environment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "environment_state", string(awstypes.EnvironmentStateCreated)),
+					resource.TestCheckResourceAttr(resourceName, "host.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "initial_vlans.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrKMSKeyID),
+					resource.TestCheckResourceAttr(resourceName, "license_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "site_id", siteID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "terms_accepted", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "vcf_hostnames.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vcf_version", "VCF-5.2.1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"host", "initial_vlans"},
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	siteID := acctest.SkipIfEnvVarNotSet(t, envVarEVSSiteID)
+	solutionKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSSolutionKey)
+	vsanKey := acctest.SkipIfEnvVarNotSet(t, envVarEVSVSANKey)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfevs.ResourceEnvironment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Elastic VMware Environment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentExists(ctx context.Context, n string, v *awstypes.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentConfig_base(rName, publicKey string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn = 65534
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_route_server_vpc_association" "test" {
+  route_server_id = aws_vpc_route_server.test.route_server_id
+  vpc_id          = aws_vpc.test.id
+}
+
+resource "aws_vpc_route_server_endpoint" "test" {
+  route_server_id = aws_vpc_route_server_vpc_association.test.route_server_id
+  subnet_id       = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_route_server_peer" "test" {
+  count = 2
+
+  route_server_endpoint_id = aws_vpc_route_server_endpoint.test.route_server_endpoint_id
+  peer_address             = cidrhost(aws_subnet.test[0].cidr_block, 250 + count.index)
+
+  bgp_options {
+    peer_asn = 65000
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_placement_group" "test" {
+  name     = %[1]q
+  strategy = "partition"
+}
+
+resource "aws_key_pair" "test" {
+  key_name   = %[1]q
+  public_key = %[2]q
+}
+`, rName, publicKey))
+}
+
+func testAccEnvironmentConfig_basic(rName, publicKey, siteID, solutionKey, vsanKey string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_evs_environment" "test" {
+  environment_name         = %[1]q
+  site_id                  = %[2]q
+  service_access_subnet_id = aws_subnet.test[0].id
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.1"
+  vpc_id                   = aws_vpc.test.id
+
+  connectivity_info {
+    private_route_server_peerings = aws_vpc_route_server_peer.test[*].route_server_peer_id
+  }
+
+  dynamic "host" {
+    for_each = range(4)
+
+    content {
+      host_name          = "esx${host.value + 1}"
+      instance_type      = "i4i.metal"
+      key_name           = aws_key_pair.test.key_name
+      placement_group_id = aws_ec2_placement_group.test.placement_group_id
+    }
+  }
+
+  initial_vlans {
+    vmk_management {
+      cidr = "10.0.10.0/24"
+    }
+    vm_management {
+      cidr = "10.0.11.0/24"
+    }
+    vmotion {
+      cidr = "10.0.12.0/24"
+    }
+    vsan {
+      cidr = "10.0.13.0/24"
+    }
+    vtep {
+      cidr = "10.0.14.0/24"
+    }
+    edge_vtep {
+      cidr = "10.0.15.0/24"
+    }
+    nsx_uplink {
+      cidr = "10.0.16.0/24"
+    }
+    hcx {
+      cidr = "10.0.17.0/24"
+    }
+    expansion_vlan_1 {
+      cidr = "10.0.18.0/24"
+    }
+    expansion_vlan_2 {
+      cidr = "10.0.19.0/24"
+    }
+  }
+
+  license_info {
+    solution_key = %[3]q
+    vsan_key     = %[4]q
+  }
+
+  vcf_hostnames {
+    cloud_builder = "cb"
+    nsx           = "nsx"
+    nsx_edge_1    = "edge1"
+    nsx_edge_2    = "edge2"
+    nsx_manager_1 = "nsxm1"
+    nsx_manager_2 = "nsxm2"
+    nsx_manager_3 = "nsxm3"
+    sddc_manager  = "sddcm"
+    vcenter       = "vc"
+  }
+}
+`, rName, siteID, solutionKey, vsanKey))
+}
@@ -3,4 +3,82 @@
 
 package evs
 
-func RegisterSweepers() {}
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_evs_environment", sweepEnvironments, "aws_evs_environment_host")
+	awsv2.Register("aws_evs_environment_host", sweepEnvironmentHosts)
+}
+
+func sweepEnvironments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EVSClient(ctx)
+	input := evs.ListEnvironmentsInput{
+		State: []awstypes.EnvironmentState{awstypes.EnvironmentStateCreated, awstypes.EnvironmentStateCreateFailed},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for v, err := range listEnvironments(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		sweepResources = append(sweepResources, framework.NewSweepResource(newEnvironmentResource, client,
+			framework.NewAttribute(names.AttrID, aws.ToString(v.EnvironmentId)),
+		))
+	}
+
+	return sweepResources, nil
+}
+
+func sweepEnvironmentHosts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EVSClient(ctx)
+	input := evs.ListEnvironmentsInput{
+		State: []awstypes.EnvironmentState{awstypes.EnvironmentStateCreated},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for v, err := range listEnvironments(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		environmentID := aws.ToString(v.EnvironmentId)
+		input := evs.ListEnvironmentHostsInput{
+			EnvironmentId: aws.String(environmentID),
+		}
+		hosts, err := findEnvironmentHosts(ctx, conn, &input, func(v *awstypes.Host) bool {
+			return v.HostState == awstypes.HostStateCreated
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The minimum number of hosts are removed along with the environment itself.
+		if n := len(hosts); n <= environmentMinHosts {
+			log.Printf("[INFO] Skipping Elastic VMware Environment (%s) Hosts: %d hosts", environmentID, n)
+			continue
+		}
+
+		for _, v := range hosts[environmentMinHosts:] {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEnvironmentHostResource, client,
+				framework.NewAttribute("environment_id", environmentID),
+				framework.NewAttribute("host_name", aws.ToString(v.HostName)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -22,11 +24,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -130,6 +135,14 @@ func resourceKey() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_kms_key")
+func keyResourceAsListResource() inttypes.ListResourceForSDK {
+	l := keyListResource{}
+	l.SetResourceSchema(resourceKey())
+
+	return &l
+}
+
 func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -234,32 +247,37 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is not a multi-Region primary key", d.Id())
 	}
 
-	d.Set(names.AttrARN, key.metadata.Arn)
-	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
-	d.Set("customer_master_key_spec", key.metadata.KeySpec)
-	d.Set(names.AttrDescription, key.metadata.Description)
+	resourceKeyMetadataFlatten(key.metadata, d)
 	d.Set("enable_key_rotation", key.rotation)
-	d.Set("is_enabled", key.metadata.Enabled)
-	d.Set(names.AttrKeyID, key.metadata.KeyId)
-	d.Set("key_usage", key.metadata.KeyUsage)
-	d.Set("multi_region", key.metadata.MultiRegion)
 	policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), key.policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 	d.Set(names.AttrPolicy, policyToSet)
 	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
-	if v := key.metadata.XksKeyConfiguration; v != nil {
-		d.Set("xks_key_id", v.Id)
-	} else {
-		d.Set("xks_key_id", nil)
-	}
 
 	setTagsOut(ctx, key.tags)
 
 	return diags
 }
 
+// resourceKeyMetadataFlatten sets the attributes available from the DescribeKey output.
+func resourceKeyMetadataFlatten(metadata *awstypes.KeyMetadata, d *schema.ResourceData) {
+	d.Set(names.AttrARN, metadata.Arn)
+	d.Set("custom_key_store_id", metadata.CustomKeyStoreId)
+	d.Set("customer_master_key_spec", metadata.KeySpec)
+	d.Set(names.AttrDescription, metadata.Description)
+	d.Set("is_enabled", metadata.Enabled)
+	d.Set(names.AttrKeyID, metadata.KeyId)
+	d.Set("key_usage", metadata.KeyUsage)
+	d.Set("multi_region", metadata.MultiRegion)
+	if v := metadata.XksKeyConfiguration; v != nil {
+		d.Set("xks_key_id", v.Id)
+	} else {
+		d.Set("xks_key_id", nil)
+	}
+}
+
 func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -772,3 +790,156 @@ func waitKeyStatePropagated(ctx context.Context, conn *kms.Client, keyID string,
 
 	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
 }
+
+var _ list.ListResourceWithRawV5Schemas = &keyListResource{}
+
+type keyListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type keyListResourceModel struct {
+	framework.WithRegionModel
+	Tags tftags.Map `tfsdk:"tags"`
+}
+
+func (l *keyListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: tftags.ListResourceTagsAttribute(),
+		},
+	}
+}
+
+func (l *keyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.KMSClient(ctx)
+
+	var query keyListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tagsFilter := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input kms.ListKeysInput
+		pages := kms.NewListKeysPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Keys {
+				keyID := aws.ToString(v.KeyId)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), keyID)
+
+				key, err := findKeyByID(ctx, conn, keyID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading KMS Key (%s): %w", keyID, err))
+					yield(result)
+					return
+				}
+
+				// AWS managed keys, imported key material keys and multi-Region replica keys are managed by other resource types.
+				if key.KeyManager != awstypes.KeyManagerTypeCustomer || key.Origin == awstypes.OriginTypeExternal {
+					continue
+				}
+				if aws.ToBool(key.MultiRegion) && key.MultiRegionConfiguration.MultiRegionKeyType != awstypes.MultiRegionKeyTypePrimary {
+					continue
+				}
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(keyID)
+
+				tflog.Info(ctx, "Reading resource")
+				resourceKeyMetadataFlatten(key, rd)
+
+				if len(tagsFilter) > 0 || request.IncludeResource {
+					// set tags
+					err = l.SetTags(ctx, awsClient, rd)
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					if !l.MatchesTags(ctx, rd, tagsFilter) {
+						continue
+					}
+				}
+
+				if v := aws.ToString(key.Description); v != "" {
+					result.DisplayName = fmt.Sprintf("%s (%s)", v, keyID)
+				} else {
+					result.DisplayName = keyID
+				}
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading additional resource data")
+
+					policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+					if tfresource.NotFound(err) {
+						tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+						continue
+					}
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading KMS Key (%s) policy: %w", keyID, err))
+						yield(result)
+						return
+					}
+
+					policyToSet, err := structure.NormalizeJsonString(aws.ToString(policy))
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("policy contains invalid JSON: %w", err))
+						yield(result)
+						return
+					}
+					rd.Set(names.AttrPolicy, policyToSet)
+
+					if key.Origin == awstypes.OriginTypeAwsKms {
+						rotation, rotationPeriodInDays, err := findKeyRotationEnabledByKeyID(ctx, conn, keyID)
+						if tfresource.NotFound(err) {
+							tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+							continue
+						}
+						if err != nil {
+							result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err))
+							yield(result)
+							return
+						}
+
+						rd.Set("enable_key_rotation", rotation)
+						rd.Set("rotation_period_in_days", rotationPeriodInDays)
+					}
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
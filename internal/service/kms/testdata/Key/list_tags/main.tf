@@ -0,0 +1,32 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_kms_key" "expected" {
+  count = 2
+
+  description             = "${var.rName}-expected-${count.index}"
+  deletion_window_in_days = 7
+
+  tags = {
+    (var.rName) = "expected"
+  }
+}
+
+resource "aws_kms_key" "not_expected" {
+  count = 1
+
+  description             = "${var.rName}-not_expected-${count.index}"
+  deletion_window_in_days = 7
+
+  tags = {
+    (var.rName) = "not-expected"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
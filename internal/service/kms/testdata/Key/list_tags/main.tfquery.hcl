@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_kms_key" "test" {
+  provider = aws
+
+  config {
+    tags = {
+      (var.rName) = "expected"
+    }
+  }
+}
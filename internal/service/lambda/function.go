@@ -15,6 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,14 +26,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -494,6 +501,14 @@ func resourceFunction() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_lambda_function")
+func functionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := functionListResource{}
+	l.SetResourceSchema(resourceFunction())
+
+	return &l
+}
+
 func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
@@ -699,8 +714,98 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	diags = append(diags, resourceFunctionFlatten(ctx, meta.(*conns.AWSClient), output, hasQualifier, d)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !hasQualifier {
+		setTagsOut(ctx, output.Tags)
+	}
+
+	return diags
+}
+
+// resourceFunctionFlatten sets the attributes available from the GetFunction output
+// and reads the function's latest version and code signing configuration.
+func resourceFunctionFlatten(ctx context.Context, c *conns.AWSClient, output *lambda.GetFunctionOutput, hasQualifier bool, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := c.LambdaClient(ctx)
+
 	function := output.Configuration
 	functionCode := output.Code
+	diags = append(diags, resourceFunctionConfigurationFlatten(ctx, c, function, d)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if output.Code != nil {
+		d.Set("image_uri", output.Code.ImageUri)
+	}
+	if output.Concurrency != nil {
+		d.Set("reserved_concurrent_executions", output.Concurrency.ReservedConcurrentExecutions)
+	} else {
+		d.Set("reserved_concurrent_executions", -1)
+	}
+	// Support in-place update of non-refreshable attribute.
+	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
+	d.Set("source_code_hash", d.Get("source_code_hash"))
+	d.Set("source_kms_key_arn", functionCode.SourceKMSKeyArn)
+
+	functionARN := aws.ToString(function.FunctionArn)
+	if hasQualifier {
+		d.Set("qualified_arn", functionARN)
+		d.Set("qualified_invoke_arn", invokeARN(ctx, c, functionARN))
+		d.Set(names.AttrVersion, function.Version)
+	} else {
+		latest, err := findLatestFunctionVersionByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) latest version: %s", d.Id(), err)
+		}
+
+		qualifiedARN := aws.ToString(latest.FunctionArn)
+		d.Set("qualified_arn", qualifiedARN)
+		d.Set("qualified_invoke_arn", invokeARN(ctx, c, qualifiedARN))
+		d.Set(names.AttrVersion, latest.Version)
+	}
+
+	// Currently, this functionality is only enabled in AWS Commercial partition
+	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
+	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
+	// Currently this functionality is not enabled in all Regions and returns ambiguous error codes
+	// (e.g. AccessDeniedException), so we cannot just ignore the error as we would typically.
+	if partition, region := c.Partition(ctx), c.Region(ctx); partition == endpoints.AwsPartitionID && signerServiceIsAvailable(region) {
+		var codeSigningConfigARN string
+
+		// Code Signing is only supported on zip packaged lambda functions.
+		if function.PackageType == awstypes.PackageTypeZip {
+			input := lambda.GetFunctionCodeSigningConfigInput{
+				FunctionName: aws.String(d.Id()),
+			}
+
+			output, err := conn.GetFunctionCodeSigningConfig(ctx, &input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) code signing config: %s", d.Id(), err)
+			}
+
+			if output != nil {
+				codeSigningConfigARN = aws.ToString(output.CodeSigningConfigArn)
+			}
+		}
+
+		d.Set("code_signing_config_arn", codeSigningConfigARN)
+	}
+
+	return diags
+}
+
+// resourceFunctionConfigurationFlatten sets the attributes available from a function's configuration,
+// as returned by both GetFunction and ListFunctions.
+func resourceFunctionConfigurationFlatten(ctx context.Context, c *conns.AWSClient, function *awstypes.FunctionConfiguration, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	d.Set("architectures", function.Architectures)
 	functionARN := aws.ToString(function.FunctionArn)
 	d.Set(names.AttrARN, functionARN)
@@ -730,10 +835,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set("image_config", flattenImageConfig(function.ImageConfigResponse)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting image_config: %s", err)
 	}
-	if output.Code != nil {
-		d.Set("image_uri", output.Code.ImageUri)
-	}
-	d.Set("invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
+	d.Set("invoke_arn", invokeARN(ctx, c, functionARN))
 	d.Set(names.AttrKMSKeyARN, function.KMSKeyArn)
 	d.Set("last_modified", function.LastModified)
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
@@ -744,23 +846,14 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
-	if output.Concurrency != nil {
-		d.Set("reserved_concurrent_executions", output.Concurrency.ReservedConcurrentExecutions)
-	} else {
-		d.Set("reserved_concurrent_executions", -1)
-	}
 	d.Set(names.AttrRole, function.Role)
 	d.Set("runtime", function.Runtime)
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
-	// Support in-place update of non-refreshable attribute.
-	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
 	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
 	}
-	d.Set("source_code_hash", d.Get("source_code_hash"))
 	d.Set("source_code_size", function.CodeSize)
-	d.Set("source_kms_key_arn", functionCode.SourceKMSKeyArn)
 	d.Set(names.AttrTimeout, function.Timeout)
 	tracingConfigMode := awstypes.TracingModePassThrough
 	if function.TracingConfig != nil {
@@ -786,53 +879,6 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
 
-	if hasQualifier {
-		d.Set("qualified_arn", functionARN)
-		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
-		d.Set(names.AttrVersion, function.Version)
-	} else {
-		latest, err := findLatestFunctionVersionByName(ctx, conn, d.Id())
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) latest version: %s", d.Id(), err)
-		}
-
-		qualifiedARN := aws.ToString(latest.FunctionArn)
-		d.Set("qualified_arn", qualifiedARN)
-		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), qualifiedARN))
-		d.Set(names.AttrVersion, latest.Version)
-
-		setTagsOut(ctx, output.Tags)
-	}
-
-	// Currently, this functionality is only enabled in AWS Commercial partition
-	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
-	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
-	// Currently this functionality is not enabled in all Regions and returns ambiguous error codes
-	// (e.g. AccessDeniedException), so we cannot just ignore the error as we would typically.
-	if partition, region := meta.(*conns.AWSClient).Partition(ctx), meta.(*conns.AWSClient).Region(ctx); partition == endpoints.AwsPartitionID && signerServiceIsAvailable(region) {
-		var codeSigningConfigARN string
-
-		// Code Signing is only supported on zip packaged lambda functions.
-		if function.PackageType == awstypes.PackageTypeZip {
-			input := lambda.GetFunctionCodeSigningConfigInput{
-				FunctionName: aws.String(d.Id()),
-			}
-
-			output, err := conn.GetFunctionCodeSigningConfig(ctx, &input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) code signing config: %s", d.Id(), err)
-			}
-
-			if output != nil {
-				codeSigningConfigARN = aws.ToString(output.CodeSigningConfigArn)
-			}
-		}
-
-		d.Set("code_signing_config_arn", codeSigningConfigARN)
-	}
-
 	return diags
 }
 
@@ -1805,3 +1851,122 @@ func resetNonRefreshableAttributes(d *schema.ResourceData) {
 		}
 	}
 }
+
+var _ list.ListResourceWithRawV5Schemas = &functionListResource{}
+
+type functionListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type functionListResourceModel struct {
+	framework.WithRegionModel
+	Tags tftags.Map `tfsdk:"tags"`
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: tftags.ListResourceTagsAttribute(),
+		},
+	}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	var query functionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tagsFilter := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lambda.ListFunctionsInput
+		pages := lambda.NewListFunctionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, function := range page.Functions {
+				functionName := aws.ToString(function.FunctionName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), functionName)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(functionName)
+				rd.Set("function_name", functionName)
+
+				tflog.Info(ctx, "Reading resource")
+				if diags := resourceFunctionConfigurationFlatten(ctx, awsClient, &function, rd); diags.HasError() {
+					result = fwdiag.NewListResultErrorDiagnostic(sdkdiag.DiagnosticsError(diags))
+					yield(result)
+					return
+				}
+
+				if len(tagsFilter) > 0 || request.IncludeResource {
+					// set tags
+					err = l.SetTags(ctx, awsClient, rd)
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					if !l.MatchesTags(ctx, rd, tagsFilter) {
+						continue
+					}
+				}
+
+				result.DisplayName = functionName
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading additional resource data")
+
+					input := lambda.GetFunctionInput{
+						FunctionName: aws.String(functionName),
+					}
+					output, err := findFunction(ctx, conn, &input)
+					if tfresource.NotFound(err) {
+						tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+						continue
+					}
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading Lambda Function (%s): %w", functionName, err))
+						yield(result)
+						return
+					}
+
+					if diags := resourceFunctionFlatten(ctx, awsClient, output, false, rd); diags.HasError() {
+						result = fwdiag.NewListResultErrorDiagnostic(sdkdiag.DiagnosticsError(diags))
+						yield(result)
+						return
+					}
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_lambda_function" "expected" {
+  count = 2
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "${var.rName}-expected-${count.index}"
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  tags = {
+    (var.rName) = "expected"
+  }
+}
+
+resource "aws_lambda_function" "not_expected" {
+  count = 1
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "${var.rName}-not-expected-${count.index}"
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  tags = {
+    (var.rName) = "not-expected"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "lambda.amazonaws.com"
+      }
+      Effect = "Allow"
+    }]
+  })
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaaserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	workflowRunPollInterval     = 10 * time.Second
+	workflowRunProgressInterval = 1 * time.Minute
+)
+
+// @Action(aws_mwaaserverless_start_workflow_run, name="Start Workflow Run")
+func newStartWorkflowRunAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startWorkflowRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startWorkflowRunAction)(nil)
+)
+
+type startWorkflowRunAction struct {
+	framework.ActionWithModel[startWorkflowRunActionModel]
+}
+
+type startWorkflowRunActionModel struct {
+	framework.WithRegionModel
+	OverrideParameters fwtypes.MapOfString `tfsdk:"override_parameters"`
+	Timeout            types.Int64         `tfsdk:"timeout"`
+	WorkflowARN        fwtypes.ARN         `tfsdk:"workflow_arn"`
+	WorkflowVersion    types.String        `tfsdk:"workflow_version"`
+}
+
+func (a *startWorkflowRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an MWAA Serverless workflow run and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"override_parameters": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Parameters that override the workflow's default parameters for this run.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait for the workflow run to complete. Defaults to 3600 seconds (1 hour).",
+				Optional:    true,
+			},
+			"workflow_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the workflow to run.",
+				Required:    true,
+			},
+			"workflow_version": schema.StringAttribute{
+				Description: "The version of the workflow to run. Defaults to the latest version.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startWorkflowRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startWorkflowRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().MWAAServerlessClient(ctx)
+
+	workflowARN := config.WorkflowARN.ValueString()
+
+	timeout := 1 * time.Hour
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting MWAA Serverless workflow run", map[string]any{
+		"workflow_arn":    workflowARN,
+		"timeout_seconds": int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting run of workflow %s...", workflowARN),
+	})
+
+	input := mwaaserverless.StartWorkflowRunInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		WorkflowArn: aws.String(workflowARN),
+	}
+
+	if !config.WorkflowVersion.IsNull() {
+		input.WorkflowVersion = config.WorkflowVersion.ValueStringPointer()
+	}
+
+	if !config.OverrideParameters.IsNull() {
+		input.OverrideParameters = make(map[string]document.Interface)
+		for k, v := range config.OverrideParameters.Elements() {
+			input.OverrideParameters[k] = document.NewLazyDocument(v.(types.String).ValueString())
+		}
+	}
+
+	output, err := conn.StartWorkflowRun(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting MWAA Serverless Workflow (%s) run", workflowARN), err.Error())
+
+		return
+	}
+
+	runID := aws.ToString(output.RunId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Workflow run %s started, waiting for completion...", runID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.WorkflowRunDetail], error) {
+		input := mwaaserverless.GetWorkflowRunInput{
+			RunId:       aws.String(runID),
+			WorkflowArn: aws.String(workflowARN),
+		}
+		output, err := conn.GetWorkflowRun(ctx, &input)
+
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.WorkflowRunDetail]{}, err
+		}
+
+		if output.RunDetail == nil {
+			return actionwait.FetchResult[*awstypes.WorkflowRunDetail]{}, fmt.Errorf("workflow run %s has no run detail", runID)
+		}
+
+		return actionwait.FetchResult[*awstypes.WorkflowRunDetail]{Status: actionwait.Status(output.RunDetail.RunState), Value: output.RunDetail}, nil
+	}, actionwait.Options[*awstypes.WorkflowRunDetail]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(workflowRunPollInterval),
+		ProgressInterval: workflowRunProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.WorkflowRunStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.WorkflowRunStatusStarting),
+			actionwait.Status(awstypes.WorkflowRunStatusQueued),
+			actionwait.Status(awstypes.WorkflowRunStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.WorkflowRunStatusFailed),
+			actionwait.Status(awstypes.WorkflowRunStatusTimeout),
+			actionwait.Status(awstypes.WorkflowRunStatusStopping),
+			actionwait.Status(awstypes.WorkflowRunStatusStopped),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Workflow run %s currently in state: %s", runID, fr.Status),
+			})
+		},
+	})
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Timeout waiting for MWAA Serverless workflow run",
+				fmt.Sprintf("Workflow run %s did not complete within %s: %s", runID, timeout, err))
+		} else if errors.As(err, &failureErr) {
+			detail := err.Error()
+			if fr.Value != nil && fr.Value.ErrorMessage != nil {
+				detail = fmt.Sprintf("%s: %s", detail, aws.ToString(fr.Value.ErrorMessage))
+			}
+			resp.Diagnostics.AddError("MWAA Serverless workflow run failed",
+				fmt.Sprintf("Workflow run %s: %s", runID, detail))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected MWAA Serverless workflow run state",
+				fmt.Sprintf("Workflow run %s: %s", runID, err))
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for MWAA Serverless Workflow (%s) run (%s)", workflowARN, runID), err.Error())
+		}
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Workflow run %s completed successfully", runID),
+	})
+}
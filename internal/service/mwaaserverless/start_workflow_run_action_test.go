@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaaserverless/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMWAAServerlessStartWorkflowRunAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_mwaaserverless_workflow.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MWAAServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartWorkflowRunActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowRunSucceeded(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowRunSucceeded(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAServerlessClient(ctx)
+
+		input := mwaaserverless.ListWorkflowRunsInput{
+			WorkflowArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+		}
+		output, err := conn.ListWorkflowRuns(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.WorkflowRuns) == 0 {
+			return fmt.Errorf("no runs found for MWAA Serverless Workflow %s", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		if detail := output.WorkflowRuns[0].RunDetailSummary; detail == nil || detail.Status != awstypes.WorkflowRunStatusSuccess {
+			return fmt.Errorf("MWAA Serverless Workflow %s run did not succeed", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccStartWorkflowRunActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_basic(rName), `
+action "aws_mwaaserverless_start_workflow_run" "test" {
+  config {
+    workflow_arn = aws_mwaaserverless_workflow.test.arn
+    timeout      = 1800
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_mwaaserverless_workflow.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_mwaaserverless_start_workflow_run.test]
+    }
+  }
+}
+`)
+}
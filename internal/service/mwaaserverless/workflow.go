@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaaserverless
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaaserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaaserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mwaaserverless_workflow", name="Workflow")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mwaaserverless;mwaaserverless;mwaaserverless.GetWorkflowOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(tagsTest=false)
+// @Testing(hasNoPreExistingResource=true)
+func newWorkflowResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workflowResource{}
+
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type workflowResource struct {
+	framework.ResourceWithModel[workflowResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+}
+
+func (r *workflowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrEngineVersion: schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trigger_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_definition": schema.StringAttribute{
+				Computed: true,
+			},
+			"workflow_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkflowStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_version": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition_s3_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[definitionS3LocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrBucket: schema.StringAttribute{
+							Required: true,
+						},
+						"object_key": schema.StringAttribute{
+							Required: true,
+						},
+						"version_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrEncryptionConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKMSKeyID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EncryptionType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			names.AttrLoggingConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[loggingConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrLogGroupName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *workflowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workflowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mwaaserverless.CreateWorkflowInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkflow(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MWAA Serverless Workflow (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.WorkflowArn)
+	workflow, err := findWorkflowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MWAA Serverless Workflow (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, workflow, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *workflowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.WorkflowARN)
+	output, err := findWorkflowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MWAA Serverless Workflow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workflowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old workflowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		arn := fwflex.StringValueFromFramework(ctx, new.WorkflowARN)
+		var input mwaaserverless.UpdateWorkflowInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWorkflow(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MWAA Serverless Workflow (%s)", arn), err.Error())
+
+			return
+		}
+
+		// Each update creates a new workflow version.
+		workflow, err := findWorkflowByARN(ctx, conn, arn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading MWAA Serverless Workflow (%s)", arn), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, workflow, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.WorkflowDefinition = old.WorkflowDefinition
+		new.WorkflowVersion = old.WorkflowVersion
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workflowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MWAAServerlessClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.WorkflowARN)
+	input := mwaaserverless.DeleteWorkflowInput{
+		WorkflowArn: aws.String(arn),
+	}
+	_, err := conn.DeleteWorkflow(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MWAA Serverless Workflow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitWorkflowDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MWAA Serverless Workflow (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func findWorkflowByARN(ctx context.Context, conn *mwaaserverless.Client, arn string) (*mwaaserverless.GetWorkflowOutput, error) {
+	input := mwaaserverless.GetWorkflowInput{
+		WorkflowArn: aws.String(arn),
+	}
+
+	return findWorkflow(ctx, conn, &input)
+}
+
+func findWorkflow(ctx context.Context, conn *mwaaserverless.Client, input *mwaaserverless.GetWorkflowInput) (*mwaaserverless.GetWorkflowOutput, error) {
+	output, err := conn.GetWorkflow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func listWorkflows(ctx context.Context, conn *mwaaserverless.Client, input *mwaaserverless.ListWorkflowsInput) iter.Seq2[awstypes.WorkflowSummary, error] {
+	return func(yield func(awstypes.WorkflowSummary, error) bool) {
+		pages := mwaaserverless.NewListWorkflowsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.WorkflowSummary{}, fmt.Errorf("listing MWAA Serverless Workflows: %w", err))
+				return
+			}
+
+			for _, v := range page.Workflows {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func statusWorkflow(ctx context.Context, conn *mwaaserverless.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findWorkflowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.WorkflowStatus), nil
+	}
+}
+
+func waitWorkflowDeleted(ctx context.Context, conn *mwaaserverless.Client, arn string, timeout time.Duration) (*mwaaserverless.GetWorkflowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkflowStatusReady, awstypes.WorkflowStatusDeleting),
+		Target:  []string{},
+		Refresh: statusWorkflow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mwaaserverless.GetWorkflowOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type workflowResourceModel struct {
+	framework.WithRegionModel
+	CreatedAt               timetypes.RFC3339                                             `tfsdk:"created_at"`
+	DefinitionS3Location    fwtypes.ListNestedObjectValueOf[definitionS3LocationModel]    `tfsdk:"definition_s3_location"`
+	Description             types.String                                                  `tfsdk:"description"`
+	EncryptionConfiguration fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	EngineVersion           types.Int32                                                   `tfsdk:"engine_version"`
+	LoggingConfiguration    fwtypes.ListNestedObjectValueOf[loggingConfigurationModel]    `tfsdk:"logging_configuration"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	NetworkConfiguration    fwtypes.ListNestedObjectValueOf[networkConfigurationModel]    `tfsdk:"network_configuration"`
+	RoleARN                 fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                    tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+	TriggerMode             types.String                                                  `tfsdk:"trigger_mode"`
+	WorkflowARN             types.String                                                  `tfsdk:"arn"`
+	WorkflowDefinition      types.String                                                  `tfsdk:"workflow_definition"`
+	WorkflowStatus          fwtypes.StringEnum[awstypes.WorkflowStatus]                   `tfsdk:"workflow_status"`
+	WorkflowVersion         types.String                                                  `tfsdk:"workflow_version"`
+}
+
+type definitionS3LocationModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	ObjectKey types.String `tfsdk:"object_key"`
+	VersionID types.String `tfsdk:"version_id"`
+}
+
+type encryptionConfigurationModel struct {
+	KMSKeyID types.String                                `tfsdk:"kms_key_id"`
+	Type     fwtypes.StringEnum[awstypes.EncryptionType] `tfsdk:"type"`
+}
+
+type loggingConfigurationModel struct {
+	LogGroupName types.String `tfsdk:"log_group_name"`
+}
+
+type networkConfigurationModel struct {
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
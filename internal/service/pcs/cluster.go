@@ -0,0 +1,781 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.Cluster")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(importIgnore="slurm_configuration")
+// @Testing(tagsTest=false)
+// @Testing(hasNoPreExistingResource=true)
+func newClusterResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+// @FrameworkListResource("aws_pcs_cluster")
+func clusterResourceAsListResource() list.ListResourceWithConfigure {
+	return &clusterResource{}
+}
+
+var _ list.ListResource = &clusterResource{}
+
+type clusterResource struct {
+	framework.ResourceWithModel[clusterResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+	framework.WithList
+}
+
+func (r *clusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:       framework.ARNAttributeComputedOnly(),
+			names.AttrEndpoints: framework.ResourceComputedListOfObjectsAttribute[endpointModel](ctx, listplanmodifier.UseStateForUnknown()),
+			names.AttrID:        framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"networking": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkingModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"network_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkType](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Optional:   true,
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"scheduler": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[schedulerModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SchedulerType](),
+							Required:   true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[clusterSlurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"scale_down_idle_time_in_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"accounting": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accountingModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"default_purge_time_in_days": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrMode: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccountingMode](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"slurm_custom_setting": slurmCustomSettingBlock(ctx),
+						"slurm_rest": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[slurmRestModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrMode: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.SlurmRestMode](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func slurmCustomSettingBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[slurmCustomSettingModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"parameter_name": schema.StringAttribute{
+					Required: true,
+				},
+				"parameter_value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input pcs.CreateClusterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("Cluster"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	id := aws.ToString(output.Cluster.Id)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	cluster, err := waitClusterCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) create", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := fwflex.StringValueFromFramework(ctx, new.ID)
+		var input pcs.UpdateClusterInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Cluster"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(sdkid.UniqueId())
+		input.ClusterIdentifier = aws.String(id)
+
+		_, err := conn.UpdateCluster(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Cluster (%s)", id), err.Error())
+
+			return
+		}
+
+		cluster, err := waitClusterUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) update", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, cluster)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := pcs.DeleteClusterInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		ClusterIdentifier: aws.String(id),
+	}
+	_, err := conn.DeleteCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *clusterResource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+}
+
+func (r *clusterResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query clusterListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.PCSClient(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input pcs.ListClustersInput
+		for item, err := range listClusters(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			var data clusterResourceModel
+
+			nullTags, nullTimeouts, diags := newNullListResultValues(ctx, result)
+			if diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+			data.Tags, data.TagsAll, data.Timeouts = nullTags, nullTags, nullTimeouts
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if diags := fwflex.Flatten(ctx, item, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			// The List API returns a summary only. Read the full resource only when requested.
+			if request.IncludeResource {
+				id := aws.ToString(item.Id)
+				cluster, err := findClusterByID(ctx, conn, id)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading PCS Cluster (%s): %w", id, err))
+					yield(result)
+					return
+				}
+
+				if diags := data.flatten(ctx, cluster); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+			}
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(item.Name)
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func findClusterByID(ctx context.Context, conn *pcs.Client, id string) (*awstypes.Cluster, error) {
+	input := pcs.GetClusterInput{
+		ClusterIdentifier: aws.String(id),
+	}
+
+	return findCluster(ctx, conn, &input)
+}
+
+func findCluster(ctx context.Context, conn *pcs.Client, input *pcs.GetClusterInput) (*awstypes.Cluster, error) {
+	output, err := conn.GetCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+func listClusters(ctx context.Context, conn *pcs.Client, input *pcs.ListClustersInput) iter.Seq2[awstypes.ClusterSummary, error] {
+	return func(yield func(awstypes.ClusterSummary, error) bool) {
+		pages := pcs.NewListClustersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.ClusterSummary{}, fmt.Errorf("listing PCS Clusters: %w", err))
+				return
+			}
+
+			for _, v := range page.Clusters {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func statusCluster(ctx context.Context, conn *pcs.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitClusterCreated(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ClusterStatusCreating),
+		Target:       enum.Slice(awstypes.ClusterStatusActive),
+		Refresh:      statusCluster(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterUpdated(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ClusterStatusUpdating),
+		Target:       enum.Slice(awstypes.ClusterStatusActive),
+		Refresh:      statusCluster(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ClusterStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusCluster(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func errorInfoError(v awstypes.ErrorInfo) error {
+	return fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Message))
+}
+
+// retainEmptyList returns the prior value of an optional block if that block was not configured.
+// PCS returns default values for Slurm settings that were not specified.
+func retainEmptyList[T any](prior, current fwtypes.ListNestedObjectValueOf[T]) fwtypes.ListNestedObjectValueOf[T] {
+	if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+		return prior
+	}
+
+	return current
+}
+
+// newNullListResultValues returns null values for the tags and timeouts attributes, which list results do not otherwise populate.
+func newNullListResultValues(ctx context.Context, result list.ListResult) (tftags.Map, timeouts.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	typ, d := result.Resource.Schema.TypeAtPath(ctx, path.Root(names.AttrTimeouts))
+	diags.Append(d...)
+	if diags.HasError() {
+		return tftags.Null, timeouts.Value{}, diags
+	}
+
+	v, ok := typ.(attr.TypeWithAttributeTypes)
+	if !ok {
+		diags.AddError("Internal Error", fmt.Sprintf("Expected value type to implement attr.TypeWithAttributeTypes, got: %T", typ))
+
+		return tftags.Null, timeouts.Value{}, diags
+	}
+
+	return tftags.Null, timeouts.Value{Object: basetypes.NewObjectNull(v.AttributeTypes())}, diags
+}
+
+type clusterResourceModel struct {
+	framework.WithRegionModel
+	ARN                types.String                                                    `tfsdk:"arn"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                  `tfsdk:"endpoints"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	Name               types.String                                                    `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                 `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                               `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	Tags               tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll            tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+func (m *clusterResourceModel) flatten(ctx context.Context, v *awstypes.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prior := m.SlurmConfiguration
+
+	diags.Append(fwflex.Flatten(ctx, v, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Nothing to retain on import.
+	if prior.IsNull() || prior.IsUnknown() {
+		return diags
+	}
+
+	if len(prior.Elements()) == 0 {
+		m.SlurmConfiguration = prior
+
+		return diags
+	}
+
+	priorData, d := prior.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data, d := m.SlurmConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if priorData == nil || data == nil {
+		return diags
+	}
+
+	data.Accounting = retainEmptyList(priorData.Accounting, data.Accounting)
+	data.SlurmRest = retainEmptyList(priorData.SlurmRest, data.SlurmRest)
+
+	m.SlurmConfiguration, d = fwtypes.NewListNestedObjectValueOfPtr(ctx, data)
+	diags.Append(d...)
+
+	return diags
+}
+
+type endpointModel struct {
+	IPv6Address      types.String                              `tfsdk:"ipv6_address"`
+	Port             types.String                              `tfsdk:"port"`
+	PrivateIPAddress types.String                              `tfsdk:"private_ip_address"`
+	PublicIPAddress  types.String                              `tfsdk:"public_ip_address"`
+	Type             fwtypes.StringEnum[awstypes.EndpointType] `tfsdk:"type"`
+}
+
+type networkingModel struct {
+	NetworkType      fwtypes.StringEnum[awstypes.NetworkType] `tfsdk:"network_type"`
+	SecurityGroupIDs fwtypes.SetOfString                      `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString                      `tfsdk:"subnet_ids"`
+}
+
+type schedulerModel struct {
+	Type    fwtypes.StringEnum[awstypes.SchedulerType] `tfsdk:"type"`
+	Version types.String                               `tfsdk:"version"`
+}
+
+type clusterSlurmConfigurationModel struct {
+	Accounting                 fwtypes.ListNestedObjectValueOf[accountingModel]         `tfsdk:"accounting"`
+	ScaleDownIdleTimeInSeconds types.Int32                                              `tfsdk:"scale_down_idle_time_in_seconds"`
+	SlurmCustomSettings        fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_setting"`
+	SlurmRest                  fwtypes.ListNestedObjectValueOf[slurmRestModel]          `tfsdk:"slurm_rest"`
+}
+
+type accountingModel struct {
+	DefaultPurgeTimeInDays types.Int32                                 `tfsdk:"default_purge_time_in_days"`
+	Mode                   fwtypes.StringEnum[awstypes.AccountingMode] `tfsdk:"mode"`
+}
+
+type slurmCustomSettingModel struct {
+	ParameterName  types.String `tfsdk:"parameter_name"`
+	ParameterValue types.String `tfsdk:"parameter_value"`
+}
+
+type slurmRestModel struct {
+	Mode fwtypes.StringEnum[awstypes.SlurmRestMode] `tfsdk:"mode"`
+}
+
+type clusterListModel struct {
+	framework.WithRegionModel
+}
@@ -0,0 +1,731 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_compute_node_group", name="Compute Node Group")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_id")
+// @IdentityAttribute("compute_node_group_id")
+// @ImportIDHandler("computeNodeGroupImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.ComputeNodeGroup")
+// @Testing(importStateIdFunc=testAccComputeNodeGroupImportStateIDFunc)
+// @Testing(importStateIdAttribute="compute_node_group_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(importIgnore="slurm_configuration;spot_options")
+// @Testing(tagsTest=false)
+// @Testing(hasNoPreExistingResource=true)
+func newComputeNodeGroupResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &computeNodeGroupResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+// @FrameworkListResource("aws_pcs_compute_node_group")
+func computeNodeGroupResourceAsListResource() list.ListResourceWithConfigure {
+	return &computeNodeGroupResource{}
+}
+
+var _ list.ListResource = &computeNodeGroupResource{}
+
+type computeNodeGroupResource struct {
+	framework.ResourceWithModel[computeNodeGroupResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+	framework.WithList
+}
+
+func (r *computeNodeGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ami_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compute_node_group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iam_instance_profile_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"purchase_option": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PurchaseOption](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"custom_launch_template": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLaunchTemplateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"instance_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[instanceConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrInstanceType: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"scaling_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scalingConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_instance_count": schema.Int32Attribute{
+							Required: true,
+						},
+						"min_instance_count": schema.Int32Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[slurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"slurm_custom_setting": slurmCustomSettingBlock(ctx),
+					},
+				},
+			},
+			"spot_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[spotOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allocation_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SpotAllocationStrategy](),
+							Optional:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *computeNodeGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, name := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.Name)
+	var input pcs.CreateComputeNodeGroupInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("ComputeNodeGroup"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.ClusterIdentifier = aws.String(clusterID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateComputeNodeGroup(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Compute Node Group (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	computeNodeGroupID := aws.ToString(output.ComputeNodeGroup.Id)
+	data.ComputeNodeGroupID = fwflex.StringValueToFramework(ctx, computeNodeGroupID)
+
+	computeNodeGroup, err := waitComputeNodeGroupCreated(ctx, conn, clusterID, computeNodeGroupID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("cluster_id"), data.ClusterID) // Set identity so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("compute_node_group_id"), data.ComputeNodeGroupID)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Compute Node Group (%s) create", computeNodeGroupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, computeNodeGroup)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *computeNodeGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, computeNodeGroupID := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.ComputeNodeGroupID)
+	output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, clusterID, computeNodeGroupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Compute Node Group (%s)", computeNodeGroupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *computeNodeGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		clusterID, computeNodeGroupID := fwflex.StringValueFromFramework(ctx, new.ClusterID), fwflex.StringValueFromFramework(ctx, new.ComputeNodeGroupID)
+		var input pcs.UpdateComputeNodeGroupInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("ComputeNodeGroup"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(sdkid.UniqueId())
+		input.ClusterIdentifier = aws.String(clusterID)
+		input.ComputeNodeGroupIdentifier = aws.String(computeNodeGroupID)
+
+		_, err := conn.UpdateComputeNodeGroup(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Compute Node Group (%s)", computeNodeGroupID), err.Error())
+
+			return
+		}
+
+		computeNodeGroup, err := waitComputeNodeGroupUpdated(ctx, conn, clusterID, computeNodeGroupID, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Compute Node Group (%s) update", computeNodeGroupID), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, computeNodeGroup)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *computeNodeGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, computeNodeGroupID := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.ComputeNodeGroupID)
+	input := pcs.DeleteComputeNodeGroupInput{
+		ClientToken:                aws.String(sdkid.UniqueId()),
+		ClusterIdentifier:          aws.String(clusterID),
+		ComputeNodeGroupIdentifier: aws.String(computeNodeGroupID),
+	}
+	_, err := conn.DeleteComputeNodeGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Compute Node Group (%s)", computeNodeGroupID), err.Error())
+
+		return
+	}
+
+	if _, err := waitComputeNodeGroupDeleted(ctx, conn, clusterID, computeNodeGroupID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Compute Node Group (%s) delete", computeNodeGroupID), err.Error())
+
+		return
+	}
+}
+
+func (r *computeNodeGroupResource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"cluster_id": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *computeNodeGroupResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query computeNodeGroupListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.PCSClient(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		clusterID := fwflex.StringValueFromFramework(ctx, query.ClusterID)
+		input := pcs.ListComputeNodeGroupsInput{
+			ClusterIdentifier: aws.String(clusterID),
+		}
+		for item, err := range listComputeNodeGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			var data computeNodeGroupResourceModel
+
+			nullTags, nullTimeouts, diags := newNullListResultValues(ctx, result)
+			if diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+			data.Tags, data.TagsAll, data.Timeouts = nullTags, nullTags, nullTimeouts
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if diags := fwflex.Flatten(ctx, item, &data, fwflex.WithFieldNamePrefix("ComputeNodeGroup")); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			// The List API returns a summary only. Read the full resource only when requested.
+			if request.IncludeResource {
+				computeNodeGroupID := aws.ToString(item.Id)
+				computeNodeGroup, err := findComputeNodeGroupByTwoPartKey(ctx, conn, clusterID, computeNodeGroupID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading PCS Compute Node Group (%s): %w", computeNodeGroupID, err))
+					yield(result)
+					return
+				}
+
+				if diags := data.flatten(ctx, computeNodeGroup); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+			}
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(item.Name)
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+var _ inttypes.ImportIDParser = computeNodeGroupImportID{}
+
+type computeNodeGroupImportID struct{}
+
+func (computeNodeGroupImportID) Parse(id string) (string, map[string]string, error) {
+	clusterID, computeNodeGroupID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <cluster-id>"+intflex.ResourceIdSeparator+"<compute-node-group-id>", id)
+	}
+
+	result := map[string]string{
+		"cluster_id":            clusterID,
+		"compute_node_group_id": computeNodeGroupID,
+	}
+
+	return id, result, nil
+}
+
+func findComputeNodeGroupByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string) (*awstypes.ComputeNodeGroup, error) {
+	input := pcs.GetComputeNodeGroupInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		ComputeNodeGroupIdentifier: aws.String(computeNodeGroupID),
+	}
+	output, err := findComputeNodeGroup(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.ComputeNodeGroupStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findComputeNodeGroup(ctx context.Context, conn *pcs.Client, input *pcs.GetComputeNodeGroupInput) (*awstypes.ComputeNodeGroup, error) {
+	output, err := conn.GetComputeNodeGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ComputeNodeGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ComputeNodeGroup, nil
+}
+
+func listComputeNodeGroups(ctx context.Context, conn *pcs.Client, input *pcs.ListComputeNodeGroupsInput) iter.Seq2[awstypes.ComputeNodeGroupSummary, error] {
+	return func(yield func(awstypes.ComputeNodeGroupSummary, error) bool) {
+		pages := pcs.NewListComputeNodeGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.ComputeNodeGroupSummary{}, fmt.Errorf("listing PCS Compute Node Groups: %w", err))
+				return
+			}
+
+			for _, v := range page.ComputeNodeGroups {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func statusComputeNodeGroup(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, clusterID, computeNodeGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitComputeNodeGroupCreated(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ComputeNodeGroupStatusCreating),
+		Target:       enum.Slice(awstypes.ComputeNodeGroupStatusActive),
+		Refresh:      statusComputeNodeGroup(ctx, conn, clusterID, computeNodeGroupID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComputeNodeGroupUpdated(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ComputeNodeGroupStatusUpdating),
+		Target:       enum.Slice(awstypes.ComputeNodeGroupStatusActive),
+		Refresh:      statusComputeNodeGroup(ctx, conn, clusterID, computeNodeGroupID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComputeNodeGroupDeleted(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ComputeNodeGroupStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusComputeNodeGroup(ctx, conn, clusterID, computeNodeGroupID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type computeNodeGroupResourceModel struct {
+	framework.WithRegionModel
+	AMIID                 types.String                                               `tfsdk:"ami_id"`
+	ARN                   types.String                                               `tfsdk:"arn"`
+	ClusterID             types.String                                               `tfsdk:"cluster_id"`
+	ComputeNodeGroupID    types.String                                               `tfsdk:"compute_node_group_id"`
+	CustomLaunchTemplate  fwtypes.ListNestedObjectValueOf[customLaunchTemplateModel] `tfsdk:"custom_launch_template"`
+	IAMInstanceProfileARN fwtypes.ARN                                                `tfsdk:"iam_instance_profile_arn"`
+	InstanceConfigs       fwtypes.ListNestedObjectValueOf[instanceConfigModel]       `tfsdk:"instance_config"`
+	Name                  types.String                                               `tfsdk:"name"`
+	PurchaseOption        fwtypes.StringEnum[awstypes.PurchaseOption]                `tfsdk:"purchase_option"`
+	ScalingConfiguration  fwtypes.ListNestedObjectValueOf[scalingConfigurationModel] `tfsdk:"scaling_configuration"`
+	SlurmConfiguration    fwtypes.ListNestedObjectValueOf[slurmConfigurationModel]   `tfsdk:"slurm_configuration"`
+	SpotOptions           fwtypes.ListNestedObjectValueOf[spotOptionsModel]          `tfsdk:"spot_options"`
+	SubnetIDs             fwtypes.SetOfString                                        `tfsdk:"subnet_ids"`
+	Tags                  tftags.Map                                                 `tfsdk:"tags"`
+	TagsAll               tftags.Map                                                 `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+func (m *computeNodeGroupResourceModel) flatten(ctx context.Context, v *awstypes.ComputeNodeGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	slurmConfiguration, spotOptions := m.SlurmConfiguration, m.SpotOptions
+
+	diags.Append(fwflex.Flatten(ctx, v, m, fwflex.WithFieldNamePrefix("ComputeNodeGroup"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.SlurmConfiguration = retainEmptyList(slurmConfiguration, m.SlurmConfiguration)
+	m.SpotOptions = retainEmptyList(spotOptions, m.SpotOptions)
+
+	return diags
+}
+
+type customLaunchTemplateModel struct {
+	ID      types.String `tfsdk:"id"`
+	Version types.String `tfsdk:"version"`
+}
+
+type instanceConfigModel struct {
+	InstanceType types.String `tfsdk:"instance_type"`
+}
+
+type scalingConfigurationModel struct {
+	MaxInstanceCount types.Int32 `tfsdk:"max_instance_count"`
+	MinInstanceCount types.Int32 `tfsdk:"min_instance_count"`
+}
+
+type slurmConfigurationModel struct {
+	SlurmCustomSettings fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_setting"`
+}
+
+type spotOptionsModel struct {
+	AllocationStrategy fwtypes.StringEnum[awstypes.SpotAllocationStrategy] `tfsdk:"allocation_strategy"`
+}
+
+type computeNodeGroupListModel struct {
+	framework.WithRegionModel
+	ClusterID types.String `tfsdk:"cluster_id"`
+}
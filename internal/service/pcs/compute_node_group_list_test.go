@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCSComputeNodeGroup_List_Basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_pcs_compute_node_group.test[0]"
+	resourceName2 := "aws_pcs_compute_node_group.test[1]"
+	resourceName3 := "aws_pcs_compute_node_group.test[2]"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	id1 := tfstatecheck.StateValue()
+	id2 := tfstatecheck.StateValue()
+	id3 := tfstatecheck.StateValue()
+	clusterID := tfstatecheck.StateValue()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, names.PCSServiceID),
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/ComputeNodeGroup/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					clusterID.GetStateValue("aws_pcs_cluster.test", tfjsonpath.New(names.AttrID)),
+					id1.GetStateValue(resourceName1, tfjsonpath.New("compute_node_group_id")),
+					id2.GetStateValue(resourceName2, tfjsonpath.New("compute_node_group_id")),
+					id3.GetStateValue(resourceName3, tfjsonpath.New("compute_node_group_id")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/ComputeNodeGroup/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_pcs_compute_node_group.test", map[string]knownvalue.Check{
+						names.AttrAccountID:     tfknownvalue.AccountID(),
+						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
+						"cluster_id":            clusterID.Value(),
+						"compute_node_group_id": id1.Value(),
+					}),
+					querycheck.ExpectIdentity("aws_pcs_compute_node_group.test", map[string]knownvalue.Check{
+						names.AttrAccountID:     tfknownvalue.AccountID(),
+						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
+						"cluster_id":            clusterID.Value(),
+						"compute_node_group_id": id2.Value(),
+					}),
+					querycheck.ExpectIdentity("aws_pcs_compute_node_group.test", map[string]knownvalue.Check{
+						names.AttrAccountID:     tfknownvalue.AccountID(),
+						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
+						"cluster_id":            clusterID.Value(),
+						"compute_node_group_id": id3.Value(),
+					}),
+					querycheck.ExpectLength("aws_pcs_compute_node_group.test", 3),
+				},
+			},
+		},
+	})
+}
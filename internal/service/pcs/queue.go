@@ -0,0 +1,612 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_id")
+// @IdentityAttribute("queue_id")
+// @ImportIDHandler("queueImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/pcs/types;awstypes;awstypes.Queue")
+// @Testing(importStateIdFunc=testAccQueueImportStateIDFunc)
+// @Testing(importStateIdAttribute="queue_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(importIgnore="slurm_configuration")
+// @Testing(tagsTest=false)
+// @Testing(hasNoPreExistingResource=true)
+func newQueueResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &queueResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+// @FrameworkListResource("aws_pcs_queue")
+func queueResourceAsListResource() list.ListResourceWithConfigure {
+	return &queueResource{}
+}
+
+var _ list.ListResource = &queueResource{}
+
+type queueResource struct {
+	framework.ResourceWithModel[queueResourceModel]
+	framework.WithImportByIdentity
+	framework.WithTimeouts
+	framework.WithList
+}
+
+func (r *queueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"queue_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"compute_node_group_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_node_group_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[slurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"slurm_custom_setting": slurmCustomSettingBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *queueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, name := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.Name)
+	var input pcs.CreateQueueInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("Queue"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.ClusterIdentifier = aws.String(clusterID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateQueue(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Queue (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	queueID := aws.ToString(output.Queue.Id)
+	data.QueueID = fwflex.StringValueToFramework(ctx, queueID)
+
+	queue, err := waitQueueCreated(ctx, conn, clusterID, queueID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("cluster_id"), data.ClusterID) // Set identity so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root("queue_id"), data.QueueID)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) create", queueID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, queue)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *queueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, queueID := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.QueueID)
+	output, err := findQueueByTwoPartKey(ctx, conn, clusterID, queueID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", queueID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *queueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		clusterID, queueID := fwflex.StringValueFromFramework(ctx, new.ClusterID), fwflex.StringValueFromFramework(ctx, new.QueueID)
+		var input pcs.UpdateQueueInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Queue"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(sdkid.UniqueId())
+		input.ClusterIdentifier = aws.String(clusterID)
+		input.QueueIdentifier = aws.String(queueID)
+
+		_, err := conn.UpdateQueue(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Queue (%s)", queueID), err.Error())
+
+			return
+		}
+
+		queue, err := waitQueueUpdated(ctx, conn, clusterID, queueID, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) update", queueID), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, queue)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *queueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	clusterID, queueID := fwflex.StringValueFromFramework(ctx, data.ClusterID), fwflex.StringValueFromFramework(ctx, data.QueueID)
+	input := pcs.DeleteQueueInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(queueID),
+	}
+	_, err := conn.DeleteQueue(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Queue (%s)", queueID), err.Error())
+
+		return
+	}
+
+	if _, err := waitQueueDeleted(ctx, conn, clusterID, queueID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) delete", queueID), err.Error())
+
+		return
+	}
+}
+
+func (r *queueResource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"cluster_id": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *queueResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query queueListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.PCSClient(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		clusterID := fwflex.StringValueFromFramework(ctx, query.ClusterID)
+		input := pcs.ListQueuesInput{
+			ClusterIdentifier: aws.String(clusterID),
+		}
+		for item, err := range listQueues(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			var data queueResourceModel
+
+			nullTags, nullTimeouts, diags := newNullListResultValues(ctx, result)
+			if diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+			data.Tags, data.TagsAll, data.Timeouts = nullTags, nullTags, nullTimeouts
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if diags := fwflex.Flatten(ctx, item, &data, fwflex.WithFieldNamePrefix("Queue")); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			// The List API returns a summary only. Read the full resource only when requested.
+			if request.IncludeResource {
+				queueID := aws.ToString(item.Id)
+				queue, err := findQueueByTwoPartKey(ctx, conn, clusterID, queueID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading PCS Queue (%s): %w", queueID, err))
+					yield(result)
+					return
+				}
+
+				if diags := data.flatten(ctx, queue); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+			}
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(item.Name)
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+var _ inttypes.ImportIDParser = queueImportID{}
+
+type queueImportID struct{}
+
+func (queueImportID) Parse(id string) (string, map[string]string, error) {
+	clusterID, queueID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <cluster-id>"+intflex.ResourceIdSeparator+"<queue-id>", id)
+	}
+
+	result := map[string]string{
+		"cluster_id": clusterID,
+		"queue_id":   queueID,
+	}
+
+	return id, result, nil
+}
+
+func findQueueByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, queueID string) (*awstypes.Queue, error) {
+	input := pcs.GetQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(queueID),
+	}
+
+	return findQueue(ctx, conn, &input)
+}
+
+func findQueue(ctx context.Context, conn *pcs.Client, input *pcs.GetQueueInput) (*awstypes.Queue, error) {
+	output, err := conn.GetQueue(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Queue, nil
+}
+
+func listQueues(ctx context.Context, conn *pcs.Client, input *pcs.ListQueuesInput) iter.Seq2[awstypes.QueueSummary, error] {
+	return func(yield func(awstypes.QueueSummary, error) bool) {
+		pages := pcs.NewListQueuesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.QueueSummary{}, fmt.Errorf("listing PCS Queues: %w", err))
+				return
+			}
+
+			for _, v := range page.Queues {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func statusQueue(ctx context.Context, conn *pcs.Client, clusterID, queueID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findQueueByTwoPartKey(ctx, conn, clusterID, queueID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueueCreated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.QueueStatusCreating),
+		Target:       enum.Slice(awstypes.QueueStatusActive),
+		Refresh:      statusQueue(ctx, conn, clusterID, queueID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueUpdated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.QueueStatusUpdating),
+		Target:       enum.Slice(awstypes.QueueStatusActive),
+		Refresh:      statusQueue(ctx, conn, clusterID, queueID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueDeleted(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.QueueStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusQueue(ctx, conn, clusterID, queueID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.ErrorInfo, errorInfoError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type queueResourceModel struct {
+	framework.WithRegionModel
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configuration"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	QueueID                        types.String                                                        `tfsdk:"queue_id"`
+	SlurmConfiguration             fwtypes.ListNestedObjectValueOf[slurmConfigurationModel]            `tfsdk:"slurm_configuration"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                        tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+func (m *queueResourceModel) flatten(ctx context.Context, v *awstypes.Queue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	slurmConfiguration := m.SlurmConfiguration
+
+	diags.Append(fwflex.Flatten(ctx, v, m, fwflex.WithFieldNamePrefix("Queue"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.SlurmConfiguration = retainEmptyList(slurmConfiguration, m.SlurmConfiguration)
+
+	return diags
+}
+
+type computeNodeGroupConfigurationModel struct {
+	ComputeNodeGroupID types.String `tfsdk:"compute_node_group_id"`
+}
+
+type queueListModel struct {
+	framework.WithRegionModel
+	ClusterID types.String `tfsdk:"cluster_id"`
+}
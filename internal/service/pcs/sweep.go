@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_pcs_cluster", sweepClusters, "aws_pcs_compute_node_group", "aws_pcs_queue")
+	awsv2.Register("aws_pcs_compute_node_group", sweepComputeNodeGroups, "aws_pcs_queue")
+	awsv2.Register("aws_pcs_queue", sweepQueues)
+}
+
+func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCSClient(ctx)
+	var input pcs.ListClustersInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for v, err := range listClusters(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		sweepResources = append(sweepResources, framework.NewSweepResource(newClusterResource, client,
+			framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+		))
+	}
+
+	return sweepResources, nil
+}
+
+func sweepComputeNodeGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCSClient(ctx)
+	var input pcs.ListClustersInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for cluster, err := range listClusters(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		clusterID := aws.ToString(cluster.Id)
+		inputLCNG := pcs.ListComputeNodeGroupsInput{
+			ClusterIdentifier: aws.String(clusterID),
+		}
+		for v, err := range listComputeNodeGroups(ctx, conn, &inputLCNG) {
+			if err != nil {
+				return nil, err
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newComputeNodeGroupResource, client,
+				framework.NewAttribute("cluster_id", clusterID),
+				framework.NewAttribute("compute_node_group_id", aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepQueues(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCSClient(ctx)
+	var input pcs.ListClustersInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for cluster, err := range listClusters(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		clusterID := aws.ToString(cluster.Id)
+		inputLQ := pcs.ListQueuesInput{
+			ClusterIdentifier: aws.String(clusterID),
+		}
+		for v, err := range listQueues(ctx, conn, &inputLQ) {
+			if err != nil {
+				return nil, err
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newQueueResource, client,
+				framework.NewAttribute("cluster_id", clusterID),
+				framework.NewAttribute("queue_id", aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
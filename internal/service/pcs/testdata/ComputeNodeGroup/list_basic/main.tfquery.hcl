@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_pcs_compute_node_group" "test" {
+  provider = aws
+
+  config {
+    cluster_id = aws_pcs_cluster.test.id
+  }
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSIAMAuthTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	hostname := fmt.Sprintf("%s.cluster-123456789012.%s.rds.amazonaws.com", rName, acctest.Region())
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.RDSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMAuthTokenEphemeralResourceConfig_basic(hostname),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("hostname"), knownvalue.StringExact(hostname)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrPort), knownvalue.Int64Exact(5432)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), knownvalue.StringRegexp(regexache.MustCompile(`:5432/\?Action=connect&DBUser=iam_user&`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrUsername), knownvalue.StringExact("iam_user")),
+				},
+			},
+		},
+	})
+}
+
+func testAccIAMAuthTokenEphemeralResourceConfig_basic(hostname string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_rds_iam_auth_token.test"),
+		fmt.Sprintf(`
+ephemeral "aws_rds_iam_auth_token" "test" {
+  hostname = %[1]q
+  port     = 5432
+  username = "iam_user"
+}
+`, hostname))
+}
@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_db_instance" "test" {
+  provider = aws
+
+  config {
+    tags = {
+      (var.rName) = "expected"
+    }
+  }
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -33,11 +36,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -718,6 +725,14 @@ func resourceBucket() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_s3_bucket")
+func bucketResourceAsListResource() inttypes.ListResourceForSDK {
+	l := bucketListResource{}
+	l.SetResourceSchema(resourceBucket())
+
+	return &l
+}
+
 func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	c := meta.(*conns.AWSClient)
@@ -832,6 +847,13 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", d.Id(), err)
 	}
 
+	return append(diags, resourceBucketFlatten(ctx, c, d)...)
+}
+
+func resourceBucketFlatten(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := c.S3Client(ctx)
+
 	d.Set(names.AttrARN, bucketARN(ctx, c, d.Id()))
 	d.Set(names.AttrBucket, d.Id())
 	d.Set("bucket_domain_name", c.PartitionHostname(ctx, d.Id()+".s3"))
@@ -3038,3 +3060,123 @@ func validBucketLifecycleTimestamp(v any, k string) (ws []string, errors []error
 
 	return
 }
+
+var _ list.ListResourceWithRawV5Schemas = &bucketListResource{}
+
+type bucketListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type bucketListResourceModel struct {
+	framework.WithRegionModel
+	Tags tftags.Map `tfsdk:"tags"`
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: tftags.ListResourceTagsAttribute(),
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.S3Client(ctx)
+
+	var query bucketListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tagsFilter := tftags.New(ctx, query.Tags)
+
+	// Only list general purpose buckets in the configured Region.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(awsClient.Region(ctx)),
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, bucket := range page.Buckets {
+				bucketName := aws.ToString(bucket.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), bucketName)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(bucketName)
+
+				tflog.Info(ctx, "Reading resource")
+				resourceBucketListItemFlatten(ctx, awsClient, &bucket, rd)
+
+				if len(tagsFilter) > 0 || request.IncludeResource {
+					// set tags
+					err = l.SetTags(ctx, awsClient, rd)
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					if !l.MatchesTags(ctx, rd, tagsFilter) {
+						continue
+					}
+				}
+
+				result.DisplayName = bucketName
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading additional resource data")
+					if diags := resourceBucketFlatten(ctx, awsClient, rd); diags.HasError() {
+						result = fwdiag.NewListResultErrorDiagnostic(sdkdiag.DiagnosticsError(diags))
+						yield(result)
+						return
+					}
+					if rd.Id() == "" {
+						tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+						continue
+					}
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// resourceBucketListItemFlatten sets the attributes available from the ListBuckets output.
+func resourceBucketListItemFlatten(ctx context.Context, c *conns.AWSClient, bucket *types.Bucket, d *schema.ResourceData) {
+	bucketName := aws.ToString(bucket.Name)
+	region := aws.ToString(bucket.BucketRegion)
+
+	d.Set(names.AttrARN, bucketARN(ctx, c, bucketName))
+	d.Set(names.AttrBucket, bucketName)
+	d.Set("bucket_domain_name", c.PartitionHostname(ctx, bucketName+".s3"))
+	d.Set(names.AttrBucketPrefix, create.NamePrefixFromName(bucketName))
+	d.Set("bucket_region", region)
+	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(bucketName, region))
+}
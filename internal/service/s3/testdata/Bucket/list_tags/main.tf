@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_s3_bucket" "expected" {
+  count = 2
+
+  bucket = "${var.rName}-expected-${count.index}"
+
+  tags = {
+    (var.rName) = "expected"
+  }
+}
+
+resource "aws_s3_bucket" "not_expected" {
+  count = 1
+
+  bucket = "${var.rName}-not-expected-${count.index}"
+
+  tags = {
+    (var.rName) = "not-expected"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
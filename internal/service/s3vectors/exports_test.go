@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+var (
+	ResourceIndex              = newIndexResource
+	ResourceVectorBucket       = newVectorBucketResource
+	ResourceVectorBucketPolicy = newVectorBucketPolicyResource
+
+	FindIndexByARN              = findIndexByARN
+	FindVectorBucketByARN       = findVectorBucketByARN
+	FindVectorBucketPolicyByARN = findVectorBucketPolicyByARN
+)
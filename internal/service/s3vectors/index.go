@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3vectors_index", name="Index")
+// @ArnIdentity("index_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3vectors/types;awstypes;awstypes.Index")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newIndexResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &indexResource{}, nil
+}
+
+// @FrameworkListResource("aws_s3vectors_index")
+func indexResourceAsListResource() list.ListResourceWithConfigure {
+	return &indexResource{}
+}
+
+var _ list.ListResource = &indexResource{}
+
+type indexResource struct {
+	framework.ResourceWithModel[indexResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+	framework.WithList
+}
+
+func (r *indexResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dimension": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(1, 4096),
+				},
+			},
+			"distance_metric": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DistanceMetric](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_arn": framework.ARNAttributeComputedOnly(),
+			"index_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z.-]+$`), "must contain only lowercase letters, numbers, periods, or hyphens"),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z].*[0-9a-z]$`), "must start and end with a letter or number"),
+				},
+			},
+			"vector_bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[indexMetadataConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"non_filterable_metadata_keys": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *indexResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data indexResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.IndexName)
+	var input s3vectors.CreateIndexInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateIndex(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Vectors Index (%s)", name), err.Error())
+
+		return
+	}
+
+	index, err := findIndexByARN(ctx, conn, aws.ToString(output.IndexArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Index (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, index, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *indexResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data indexResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	indexARN := fwflex.StringValueFromFramework(ctx, data.IndexARN)
+	output, err := findIndexByARN(ctx, conn, indexARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Index (%s)", indexARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *indexResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data indexResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	indexARN := fwflex.StringValueFromFramework(ctx, data.IndexARN)
+	input := s3vectors.DeleteIndexInput{
+		IndexArn: aws.String(indexARN),
+	}
+	_, err := conn.DeleteIndex(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Vectors Index (%s)", indexARN), err.Error())
+
+		return
+	}
+}
+
+func (r *indexResource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"vector_bucket_name": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *indexResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query indexListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.S3VectorsClient(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := s3vectors.ListIndexesInput{
+			VectorBucketName: fwflex.StringFromFramework(ctx, query.VectorBucketName),
+		}
+		for item, err := range listIndexes(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			var data indexResourceModel
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if diags := fwflex.Flatten(ctx, item, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			// The List API returns a summary only. Read the full resource only when requested.
+			if request.IncludeResource {
+				indexARN := aws.ToString(item.IndexArn)
+				index, err := findIndexByARN(ctx, conn, indexARN)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading S3 Vectors Index (%s): %w", indexARN, err))
+					yield(result)
+					return
+				}
+
+				if diags := fwflex.Flatten(ctx, index, &data); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+			}
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(item.IndexName)
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func findIndexByARN(ctx context.Context, conn *s3vectors.Client, arn string) (*awstypes.Index, error) {
+	input := s3vectors.GetIndexInput{
+		IndexArn: aws.String(arn),
+	}
+
+	return findIndex(ctx, conn, &input)
+}
+
+func findIndex(ctx context.Context, conn *s3vectors.Client, input *s3vectors.GetIndexInput) (*awstypes.Index, error) {
+	output, err := conn.GetIndex(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Index == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Index, nil
+}
+
+func listIndexes(ctx context.Context, conn *s3vectors.Client, input *s3vectors.ListIndexesInput) iter.Seq2[awstypes.IndexSummary, error] {
+	return func(yield func(awstypes.IndexSummary, error) bool) {
+		pages := s3vectors.NewListIndexesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.IndexSummary{}, fmt.Errorf("listing S3 Vectors Indexes: %w", err))
+				return
+			}
+
+			for _, index := range page.Indexes {
+				if !yield(index, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type indexResourceModel struct {
+	framework.WithRegionModel
+	CreationTime          timetypes.RFC3339                                                `tfsdk:"creation_time"`
+	DataType              fwtypes.StringEnum[awstypes.DataType]                            `tfsdk:"data_type"`
+	Dimension             types.Int32                                                      `tfsdk:"dimension"`
+	DistanceMetric        fwtypes.StringEnum[awstypes.DistanceMetric]                      `tfsdk:"distance_metric"`
+	IndexARN              types.String                                                     `tfsdk:"index_arn"`
+	IndexName             types.String                                                     `tfsdk:"index_name"`
+	MetadataConfiguration fwtypes.ListNestedObjectValueOf[indexMetadataConfigurationModel] `tfsdk:"metadata_configuration"`
+	VectorBucketName      types.String                                                     `tfsdk:"vector_bucket_name"`
+}
+
+type indexMetadataConfigurationModel struct {
+	NonFilterableMetadataKeys fwtypes.SetOfString `tfsdk:"non_filterable_metadata_keys"`
+}
+
+type indexListModel struct {
+	framework.WithRegionModel
+	VectorBucketName types.String `tfsdk:"vector_bucket_name"`
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_s3vectors_index", sweepIndexes)
+	awsv2.Register("aws_s3vectors_vector_bucket", sweepVectorBuckets, "aws_s3vectors_index")
+}
+
+func sweepIndexes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3VectorsClient(ctx)
+	var input s3vectors.ListVectorBucketsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for v, err := range listVectorBuckets(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		input := s3vectors.ListIndexesInput{
+			VectorBucketArn: v.VectorBucketArn,
+		}
+		for v, err := range listIndexes(ctx, conn, &input) {
+			if err != nil {
+				return nil, err
+			}
+
+			indexARN := aws.ToString(v.IndexArn)
+			sweepResources = append(sweepResources, sweep.WithMetadata(framework.NewSweepResource(newIndexResource, client,
+				framework.NewAttribute("index_arn", indexARN),
+			), sweep.Metadata{
+				ARN:          indexARN,
+				CreationTime: aws.ToTime(v.CreationTime),
+			}))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVectorBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3VectorsClient(ctx)
+	var input s3vectors.ListVectorBucketsInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for v, err := range listVectorBuckets(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		// Deleting a vector bucket deletes its policy.
+		vectorBucketARN := aws.ToString(v.VectorBucketArn)
+		sweepResources = append(sweepResources, sweep.WithMetadata(framework.NewSweepResource(newVectorBucketResource, client,
+			framework.NewAttribute("vector_bucket_arn", vectorBucketARN),
+		), sweep.Metadata{
+			ARN:          vectorBucketARN,
+			CreationTime: aws.ToTime(v.CreationTime),
+		}))
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_s3vectors_index" "test" {
+  provider = aws
+
+  config {
+    vector_bucket_name = var.rName
+  }
+}
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_s3vectors_vector_bucket_policy" "test" {
+  vector_bucket_arn = aws_s3vectors_vector_bucket.test.vector_bucket_arn
+  policy            = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["s3vectors:*"]
+    principals {
+      type        = "AWS"
+      identifiers = [data.aws_caller_identity.current.account_id]
+    }
+    resources = ["${aws_s3vectors_vector_bucket.test.vector_bucket_arn}/*"]
+  }
+}
+
+resource "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = var.rName
+}
+
+data "aws_caller_identity" "current" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,38 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_s3vectors_vector_bucket_policy" "test" {
+  count = 3
+
+  vector_bucket_arn = aws_s3vectors_vector_bucket.test[count.index].vector_bucket_arn
+  policy            = data.aws_iam_policy_document.test[count.index].json
+}
+
+data "aws_iam_policy_document" "test" {
+  count = 3
+
+  statement {
+    actions = ["s3vectors:*"]
+    principals {
+      type        = "AWS"
+      identifiers = [data.aws_caller_identity.current.account_id]
+    }
+    resources = ["${aws_s3vectors_vector_bucket.test[count.index].vector_bucket_arn}/*"]
+  }
+}
+
+resource "aws_s3vectors_vector_bucket" "test" {
+  count = 3
+
+  vector_bucket_name = "${var.rName}-${count.index}"
+}
+
+data "aws_caller_identity" "current" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,40 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_s3vectors_vector_bucket_policy" "test" {
+  region = var.region
+
+  vector_bucket_arn = aws_s3vectors_vector_bucket.test.vector_bucket_arn
+  policy            = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["s3vectors:*"]
+    principals {
+      type        = "AWS"
+      identifiers = [data.aws_caller_identity.current.account_id]
+    }
+    resources = ["${aws_s3vectors_vector_bucket.test.vector_bucket_arn}/*"]
+  }
+}
+
+resource "aws_s3vectors_vector_bucket" "test" {
+  region = var.region
+
+  vector_bucket_name = var.rName
+}
+
+data "aws_caller_identity" "current" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
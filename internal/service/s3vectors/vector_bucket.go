@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3vectors_vector_bucket", name="Vector Bucket")
+// @ArnIdentity("vector_bucket_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3vectors/types;awstypes;awstypes.VectorBucket")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newVectorBucketResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &vectorBucketResource{}, nil
+}
+
+// @FrameworkListResource("aws_s3vectors_vector_bucket")
+func vectorBucketResourceAsListResource() list.ListResourceWithConfigure {
+	return &vectorBucketResource{}
+}
+
+var _ list.ListResource = &vectorBucketResource{}
+
+type vectorBucketResource struct {
+	framework.ResourceWithModel[vectorBucketResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+	framework.WithList
+}
+
+func (r *vectorBucketResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrEncryptionConfiguration: schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[vectorBucketEncryptionConfigurationModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIfConfigured(),
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vector_bucket_arn": framework.ARNAttributeComputedOnly(),
+			"vector_bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z-]+$`), "must contain only lowercase letters, numbers, or hyphens"),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z].*[0-9a-z]$`), "must start and end with a letter or number"),
+				},
+			},
+		},
+	}
+}
+
+func (r *vectorBucketResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vectorBucketResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.VectorBucketName)
+	var input s3vectors.CreateVectorBucketInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateVectorBucket(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Vectors Vector Bucket (%s)", name), err.Error())
+
+		return
+	}
+
+	vectorBucket, err := findVectorBucketByARN(ctx, conn, aws.ToString(output.VectorBucketArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Vector Bucket (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, vectorBucket, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *vectorBucketResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vectorBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	vectorBucketARN := fwflex.StringValueFromFramework(ctx, data.VectorBucketARN)
+	output, err := findVectorBucketByARN(ctx, conn, vectorBucketARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Vector Bucket (%s)", vectorBucketARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vectorBucketResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vectorBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	vectorBucketARN := fwflex.StringValueFromFramework(ctx, data.VectorBucketARN)
+	input := s3vectors.DeleteVectorBucketInput{
+		VectorBucketArn: aws.String(vectorBucketARN),
+	}
+	_, err := conn.DeleteVectorBucket(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Vectors Vector Bucket (%s)", vectorBucketARN), err.Error())
+
+		return
+	}
+}
+
+func (r *vectorBucketResource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+}
+
+func (r *vectorBucketResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query vectorBucketListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.S3VectorsClient(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input s3vectors.ListVectorBucketsInput
+		for item, err := range listVectorBuckets(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			var data vectorBucketResourceModel
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if diags := fwflex.Flatten(ctx, item, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			// The List API returns a summary only. Read the full resource only when requested.
+			if request.IncludeResource {
+				vectorBucketARN := aws.ToString(item.VectorBucketArn)
+				vectorBucket, err := findVectorBucketByARN(ctx, conn, vectorBucketARN)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading S3 Vectors Vector Bucket (%s): %w", vectorBucketARN, err))
+					yield(result)
+					return
+				}
+
+				if diags := fwflex.Flatten(ctx, vectorBucket, &data); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+			}
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(item.VectorBucketName)
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func findVectorBucketByARN(ctx context.Context, conn *s3vectors.Client, arn string) (*awstypes.VectorBucket, error) {
+	input := s3vectors.GetVectorBucketInput{
+		VectorBucketArn: aws.String(arn),
+	}
+
+	return findVectorBucket(ctx, conn, &input)
+}
+
+func findVectorBucket(ctx context.Context, conn *s3vectors.Client, input *s3vectors.GetVectorBucketInput) (*awstypes.VectorBucket, error) {
+	output, err := conn.GetVectorBucket(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VectorBucket == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VectorBucket, nil
+}
+
+func listVectorBuckets(ctx context.Context, conn *s3vectors.Client, input *s3vectors.ListVectorBucketsInput) iter.Seq2[awstypes.VectorBucketSummary, error] {
+	return func(yield func(awstypes.VectorBucketSummary, error) bool) {
+		pages := s3vectors.NewListVectorBucketsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.VectorBucketSummary{}, fmt.Errorf("listing S3 Vectors Vector Buckets: %w", err))
+				return
+			}
+
+			for _, vectorBucket := range page.VectorBuckets {
+				if !yield(vectorBucket, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type vectorBucketResourceModel struct {
+	framework.WithRegionModel
+	CreationTime            timetypes.RFC3339                                               `tfsdk:"creation_time"`
+	EncryptionConfiguration fwtypes.ObjectValueOf[vectorBucketEncryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	VectorBucketARN         types.String                                                    `tfsdk:"vector_bucket_arn"`
+	VectorBucketName        types.String                                                    `tfsdk:"vector_bucket_name"`
+}
+
+type vectorBucketEncryptionConfigurationModel struct {
+	KMSKeyARN fwtypes.ARN                          `tfsdk:"kms_key_arn"`
+	SSEType   fwtypes.StringEnum[awstypes.SseType] `tfsdk:"sse_type"`
+}
+
+type vectorBucketListModel struct {
+	framework.WithRegionModel
+}
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3vectors_vector_bucket_policy", name="Vector Bucket Policy")
+// @ArnIdentity("vector_bucket_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3vectors;s3vectors.GetVectorBucketPolicyOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(importIgnore="policy")
+// @Testing(hasNoPreExistingResource=true)
+func newVectorBucketPolicyResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &vectorBucketPolicyResource{}, nil
+}
+
+// @FrameworkListResource("aws_s3vectors_vector_bucket_policy")
+func vectorBucketPolicyResourceAsListResource() list.ListResourceWithConfigure {
+	return &vectorBucketPolicyResource{}
+}
+
+var _ list.ListResource = &vectorBucketPolicyResource{}
+
+type vectorBucketPolicyResource struct {
+	framework.ResourceWithModel[vectorBucketPolicyResourceModel]
+	framework.WithImportByIdentity
+	framework.WithList
+}
+
+func (r *vectorBucketPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"vector_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vectorBucketPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vectorBucketPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	vectorBucketARN := fwflex.StringValueFromFramework(ctx, data.VectorBucketARN)
+	var input s3vectors.PutVectorBucketPolicyInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutVectorBucketPolicy(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Vectors Vector Bucket Policy (%s)", vectorBucketARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *vectorBucketPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vectorBucketPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	vectorBucketARN := fwflex.StringValueFromFramework(ctx, data.VectorBucketARN)
+	output, err := findVectorBucketPolicyByARN(ctx, conn, vectorBucketARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Vector Bucket Policy (%s)", vectorBucketARN), err.Error())
+
+		return
+	}
+
+	data.Policy = fwtypes.IAMPolicyValue(aws.ToString(output.Policy))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vectorBucketPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new vectorBucketPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	vectorBucketARN := fwflex.StringValueFromFramework(ctx, new.VectorBucketARN)
+	var input s3vectors.PutVectorBucketPolicyInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutVectorBucketPolicy(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Vectors Vector Bucket Policy (%s)", vectorBucketARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, new)...)
+}
+
+func (r *vectorBucketPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vectorBucketPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3VectorsClient(ctx)
+
+	vectorBucketARN := fwflex.StringValueFromFramework(ctx, data.VectorBucketARN)
+	input := s3vectors.DeleteVectorBucketPolicyInput{
+		VectorBucketArn: aws.String(vectorBucketARN),
+	}
+	_, err := conn.DeleteVectorBucketPolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Vectors Vector Bucket Policy (%s)", vectorBucketARN), err.Error())
+
+		return
+	}
+}
+
+func (r *vectorBucketPolicyResource) ListResourceConfigSchema(_ context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+}
+
+func (r *vectorBucketPolicyResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query vectorBucketPolicyListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.S3VectorsClient(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input s3vectors.ListVectorBucketsInput
+		for item, err := range listVectorBuckets(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Only vector buckets with a policy are listed.
+			vectorBucketARN := aws.ToString(item.VectorBucketArn)
+			output, err := findVectorBucketPolicyByARN(ctx, conn, vectorBucketARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading S3 Vectors Vector Bucket Policy (%s): %w", vectorBucketARN, err))
+				yield(result)
+				return
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			data := vectorBucketPolicyResourceModel{
+				Policy:          fwtypes.IAMPolicyValue(aws.ToString(output.Policy)),
+				VectorBucketARN: fwtypes.ARNValue(vectorBucketARN),
+			}
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = aws.ToString(item.VectorBucketName)
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func findVectorBucketPolicyByARN(ctx context.Context, conn *s3vectors.Client, vectorBucketARN string) (*s3vectors.GetVectorBucketPolicyOutput, error) {
+	input := s3vectors.GetVectorBucketPolicyInput{
+		VectorBucketArn: aws.String(vectorBucketARN),
+	}
+
+	return findVectorBucketPolicy(ctx, conn, &input)
+}
+
+func findVectorBucketPolicy(ctx context.Context, conn *s3vectors.Client, input *s3vectors.GetVectorBucketPolicyInput) (*s3vectors.GetVectorBucketPolicyOutput, error) {
+	output, err := conn.GetVectorBucketPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.ToString(output.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type vectorBucketPolicyResourceModel struct {
+	framework.WithRegionModel
+	Policy          fwtypes.IAMPolicy `tfsdk:"policy"`
+	VectorBucketARN fwtypes.ARN       `tfsdk:"vector_bucket_arn"`
+}
+
+type vectorBucketPolicyListModel struct {
+	framework.WithRegionModel
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3VectorsVectorBucketPolicy_List_Basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_s3vectors_vector_bucket_policy.test[0]"
+	resourceName2 := "aws_s3vectors_vector_bucket_policy.test[1]"
+	resourceName3 := "aws_s3vectors_vector_bucket_policy.test[2]"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		CheckDestroy: testAccCheckVectorBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/VectorBucketPolicy/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName1, tfjsonpath.New("vector_bucket_arn"), tfknownvalue.RegionalARNExact("s3vectors", "bucket/"+rName+"-0")),
+					statecheck.ExpectKnownValue(resourceName2, tfjsonpath.New("vector_bucket_arn"), tfknownvalue.RegionalARNExact("s3vectors", "bucket/"+rName+"-1")),
+					statecheck.ExpectKnownValue(resourceName3, tfjsonpath.New("vector_bucket_arn"), tfknownvalue.RegionalARNExact("s3vectors", "bucket/"+rName+"-2")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/VectorBucketPolicy/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_s3vectors_vector_bucket_policy.test", map[string]knownvalue.Check{
+						"vector_bucket_arn": tfknownvalue.RegionalARNExact("s3vectors", "bucket/"+rName+"-0"),
+					}),
+					querycheck.ExpectIdentity("aws_s3vectors_vector_bucket_policy.test", map[string]knownvalue.Check{
+						"vector_bucket_arn": tfknownvalue.RegionalARNExact("s3vectors", "bucket/"+rName+"-1"),
+					}),
+					querycheck.ExpectIdentity("aws_s3vectors_vector_bucket_policy.test", map[string]knownvalue.Check{
+						"vector_bucket_arn": tfknownvalue.RegionalARNExact("s3vectors", "bucket/"+rName+"-2"),
+					}),
+				},
+			},
+		},
+	})
+}
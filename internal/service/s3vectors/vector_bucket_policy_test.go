@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3vectors "github.com/hashicorp/terraform-provider-aws/internal/service/s3vectors"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3VectorsVectorBucketPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3vectors.GetVectorBucketPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3vectors_vector_bucket_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVectorBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVectorBucketPolicyConfig_basic(rName, "s3vectors:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVectorBucketPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttrPair(resourceName, "vector_bucket_arn", "aws_s3vectors_vector_bucket.test", "vector_bucket_arn"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "vector_bucket_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "vector_bucket_arn",
+				ImportStateVerifyIgnore:              []string{names.AttrPolicy},
+			},
+			{
+				Config: testAccVectorBucketPolicyConfig_basic(rName, "s3vectors:GetVectors"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVectorBucketPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3VectorsVectorBucketPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3vectors.GetVectorBucketPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3vectors_vector_bucket_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVectorBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVectorBucketPolicyConfig_basic(rName, "s3vectors:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVectorBucketPolicyExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3vectors.ResourceVectorBucketPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVectorBucketPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3VectorsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3vectors_vector_bucket_policy" {
+				continue
+			}
+
+			_, err := tfs3vectors.FindVectorBucketPolicyByARN(ctx, conn, rs.Primary.Attributes["vector_bucket_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Vectors Vector Bucket Policy %s still exists", rs.Primary.Attributes["vector_bucket_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVectorBucketPolicyExists(ctx context.Context, n string, v *s3vectors.GetVectorBucketPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3VectorsClient(ctx)
+
+		output, err := tfs3vectors.FindVectorBucketPolicyByARN(ctx, conn, rs.Primary.Attributes["vector_bucket_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVectorBucketPolicyConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_s3vectors_vector_bucket_policy" "test" {
+  vector_bucket_arn = aws_s3vectors_vector_bucket.test.vector_bucket_arn
+  policy            = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [%[2]q]
+    principals {
+      type        = "AWS"
+      identifiers = [data.aws_caller_identity.current.account_id]
+    }
+    resources = ["${aws_s3vectors_vector_bucket.test.vector_bucket_arn}/*"]
+  }
+}
+
+resource "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+`, rName, action)
+}
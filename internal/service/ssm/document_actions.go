@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Shared plumbing for the SSM document actions
+// (aws_ssm_send_command and aws_ssm_start_automation_execution).
+
+const (
+	// documentActionPollInterval defines polling cadence for SSM document actions.
+	documentActionPollInterval = 5 * time.Second
+	// documentActionDefaultTimeout is the default overall timeout for SSM document actions.
+	documentActionDefaultTimeout = 30 * time.Minute
+)
+
+type documentActionTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+// documentActionSchemaAttributes returns the attributes shared by the SSM document actions.
+func documentActionSchemaAttributes(operation string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"document_name": schema.StringAttribute{
+			Description: fmt.Sprintf("Name or ARN of the SSM document to %s", operation),
+			Required:    true,
+		},
+		"document_version": schema.StringAttribute{
+			Description: "Version of the SSM document to use. Defaults to the default version",
+			Optional:    true,
+		},
+		"max_concurrency": schema.StringAttribute{
+			Description: "Maximum number, or percentage, of targets processed concurrently",
+			Optional:    true,
+		},
+		"max_errors": schema.StringAttribute{
+			Description: "Maximum number, or percentage, of errors allowed before the system stops processing additional targets",
+			Optional:    true,
+		},
+		names.AttrParameters: schema.MapAttribute{
+			Description: "Map of document parameter names to lists of values",
+			ElementType: types.ListType{ElemType: types.StringType},
+			Optional:    true,
+		},
+		names.AttrTimeout: schema.Int64Attribute{
+			Description: fmt.Sprintf("Timeout in seconds to wait for the %s to complete (default: 1800)", operation),
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(60),
+				int64validator.AtMost(86400),
+			},
+		},
+	}
+}
+
+// documentActionTargetsBlock returns the targets block shared by the SSM document actions.
+func documentActionTargetsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[documentActionTargetModel](ctx),
+		Description: "Targets for the document, specified as key-value combinations such as tag:Environment or InstanceIds",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(5),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Description: "Target key, for example tag:Environment, InstanceIds or ResourceGroup",
+					Required:    true,
+				},
+				names.AttrValues: schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					Description: "Target values",
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// documentActionTimeout returns the configured timeout, or the default if not set.
+func documentActionTimeout(timeout types.Int64) time.Duration {
+	if !timeout.IsNull() {
+		return time.Duration(timeout.ValueInt64()) * time.Second
+	}
+	return documentActionDefaultTimeout
+}
+
+func expandDocumentActionParameters(ctx context.Context, tfMap types.Map) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfMap.IsNull() || tfMap.IsUnknown() {
+		return nil, diags
+	}
+
+	var apiObject map[string][]string
+	diags.Append(tfMap.ElementsAs(ctx, &apiObject, false)...)
+
+	return apiObject, diags
+}
+
+func expandDocumentActionTargets(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[documentActionTargetModel]) ([]awstypes.Target, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.Target
+	for _, v := range data {
+		apiObjects = append(apiObjects, awstypes.Target{
+			Key:    fwflex.StringFromFramework(ctx, v.Key),
+			Values: fwflex.ExpandFrameworkStringValueList(ctx, v.Values),
+		})
+	}
+
+	return apiObjects, diags
+}
+
+// targetStatusReporter sends a progress event each time the status of a target changes.
+type targetStatusReporter struct {
+	resp     *action.InvokeResponse
+	statuses map[string]string
+}
+
+func newTargetStatusReporter(resp *action.InvokeResponse) *targetStatusReporter {
+	return &targetStatusReporter{
+		resp:     resp,
+		statuses: make(map[string]string),
+	}
+}
+
+func (r *targetStatusReporter) report(target, status, detail string) {
+	if r.statuses[target] == status {
+		return
+	}
+	r.statuses[target] = status
+
+	message := fmt.Sprintf("%s: %s", target, status)
+	if detail != "" && detail != status {
+		message += fmt.Sprintf(" (%s)", detail)
+	}
+	r.resp.SendProgress(action.InvokeProgressEvent{
+		Message: message,
+	})
+}
+
+// addDocumentActionWaitError adds a diagnostic describing why waiting for an SSM document action failed.
+func addDocumentActionWaitError(resp *action.InvokeResponse, err error, what string, timeout time.Duration, output string) {
+	detail := err.Error()
+	if output != "" {
+		detail = output
+	}
+
+	switch {
+	case actionwait.IsTimeout(err):
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Timeout Waiting for %s", what),
+			fmt.Sprintf("%s did not complete within %s", what, timeout),
+		)
+	case actionwait.IsFailureState(err):
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s Failed", what),
+			detail,
+		)
+	case actionwait.IsUnexpectedState(err):
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Status", what),
+			detail,
+		)
+	default:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error Waiting for %s", what),
+			err.Error(),
+		)
+	}
+}
+
+func targetsString(apiObjects []awstypes.Target) string {
+	var s string
+	for i, v := range apiObjects {
+		if i > 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s=%v", aws.ToString(v.Key), v.Values)
+	}
+	return s
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                               `tfsdk:"comment"`
+	DocumentName    types.String                                               `tfsdk:"document_name"`
+	DocumentVersion types.String                                               `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                                       `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                               `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                               `tfsdk:"max_errors"`
+	Parameters      types.Map                                                  `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[documentActionTargetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                                `tfsdk:"timeout"`
+}
+
+// sendCommandStatus is the state of a command and its per-target invocations.
+type sendCommandStatus struct {
+	command     *awstypes.Command
+	invocations []awstypes.CommandInvocation
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := documentActionSchemaAttributes("run")
+	attributes[names.AttrComment] = schema.StringAttribute{
+		Description: "User-specified information about the command, such as a brief description of what the command should do",
+		Optional:    true,
+	}
+	attributes["instance_ids"] = schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		Description: "IDs of the managed nodes on which the command should run. Conflicts with targets",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM command document on one or more managed nodes and waits for the command to complete on every target.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"targets": documentActionTargetsBlock(ctx),
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+	timeout := documentActionTimeout(config.Timeout)
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+
+	parameters, diags := expandDocumentActionParameters(ctx, config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	targets, diags := expandDocumentActionTargets(ctx, config.Targets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+	input.Targets = targets
+
+	if (len(input.InstanceIds) == 0) == (len(input.Targets) == 0) {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of instance_ids or targets must be specified",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		"instance_ids":    input.InstanceIds,
+		"targets":         targetsString(input.Targets),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending SSM command %s...", documentName),
+	})
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	if output == nil || output.Command == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, tfresource.NewEmptyResultError(&input)),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for completion...", commandID),
+	})
+
+	reporter := newTargetStatusReporter(resp)
+	reportInvocations := func(invocations []awstypes.CommandInvocation) {
+		for _, v := range invocations {
+			reporter.report(aws.ToString(v.InstanceId), string(v.Status), aws.ToString(v.StatusDetails))
+		}
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[sendCommandStatus], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if tfresource.NotFound(err) {
+			// The command may not be visible immediately after it is sent.
+			return actionwait.FetchResult[sendCommandStatus]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[sendCommandStatus]{}, fmt.Errorf("reading command: %w", err)
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID, false)
+		if err != nil {
+			return actionwait.FetchResult[sendCommandStatus]{}, fmt.Errorf("listing command invocations: %w", err)
+		}
+
+		value := sendCommandStatus{command: command, invocations: invocations}
+		return actionwait.FetchResult[sendCommandStatus]{Status: actionwait.Status(command.Status), Value: value}, nil
+	}, actionwait.Options[sendCommandStatus]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(documentActionPollInterval),
+		ProgressInterval: documentActionPollInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if status, ok := fr.Value.(sendCommandStatus); ok {
+				reportInvocations(status.invocations)
+			}
+		},
+	})
+	reportInvocations(result.Value.invocations)
+
+	// A command can succeed overall while individual targets fail, e.g. when max_errors allows it.
+	// Fetch plugin output for every target that did not succeed.
+	var failures []string
+	if err == nil || actionwait.IsFailureState(err) {
+		invocations, findErr := findCommandInvocationsByCommandID(ctx, conn, commandID, true)
+		if findErr != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Read Command Output",
+				fmt.Sprintf("Could not list invocations of SSM command %s: %s", commandID, findErr),
+			)
+			return
+		}
+		failures = commandInvocationFailures(invocations)
+	}
+
+	if err != nil {
+		detail := fmt.Sprintf("SSM command %s (%s) finished with status %s", commandID, documentName, result.Status)
+		if len(failures) > 0 {
+			detail += ":\n\n" + strings.Join(failures, "\n\n")
+		}
+		addDocumentActionWaitError(resp, err, fmt.Sprintf("SSM Command %s", commandID), timeout, detail)
+		return
+	}
+
+	if len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			"SSM Command Failed",
+			fmt.Sprintf("SSM command %s (%s) failed on %d target(s):\n\n%s", commandID, documentName, len(failures), strings.Join(failures, "\n\n")),
+		)
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s completed successfully on %d target(s)", commandID, len(result.Value.invocations)),
+	})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":    commandID,
+		"document_name": documentName,
+	})
+}
+
+// commandInvocationFailures returns a description, including plugin output, of each invocation that did not succeed.
+func commandInvocationFailures(invocations []awstypes.CommandInvocation) []string {
+	var failures []string
+
+	for _, invocation := range invocations {
+		if invocation.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%s: %s", aws.ToString(invocation.InstanceId), aws.ToString(invocation.StatusDetails))
+		for _, plugin := range invocation.CommandPlugins {
+			fmt.Fprintf(&sb, "\n  %s (exit code %d)", aws.ToString(plugin.Name), plugin.ResponseCode)
+			if output := strings.TrimSpace(aws.ToString(plugin.Output)); output != "" {
+				fmt.Fprintf(&sb, ":\n%s", output)
+			}
+		}
+		failures = append(failures, sb.String())
+	}
+
+	return failures
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, &input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string, details bool) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   details,
+	}
+
+	return findCommandInvocations(ctx, conn, &input)
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandInvocationsInput) ([]awstypes.CommandInvocation, error) {
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				// Allow SSM Agent to register the EC2 instance as a managed node.
+				PreConfig: func() { time.Sleep(1 * time.Minute) },
+				Config:    testAccSendCommandActionConfig_basic(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommandSucceeded(ctx, "aws_instance.test", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				// Allow SSM Agent to register the EC2 instance as a managed node.
+				PreConfig:   func() { time.Sleep(1 * time.Minute) },
+				Config:      testAccSendCommandActionConfig_basic(rName, "echo boom; exit 3"),
+				ExpectError: regexache.MustCompile(`exit[\s\n]*code[\s\n]*3`),
+			},
+		},
+	})
+}
+
+// testAccCheckCommandSucceeded verifies that the command sent to the instance with the specified comment succeeded.
+func testAccCheckCommandSucceeded(ctx context.Context, n, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListCommands(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		for _, command := range output.Commands {
+			if aws.ToString(command.Comment) != comment {
+				continue
+			}
+
+			if command.Status != awstypes.CommandStatusSuccess {
+				return fmt.Errorf("SSM Command %s status is %s, expected %s", aws.ToString(command.CommandId), command.Status, awstypes.CommandStatusSuccess)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("SSM Command with comment %q not found for instance %s", comment, rs.Primary.ID)
+	}
+}
+
+func testAccSendCommandActionConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    comment       = %[1]q
+    timeout       = 600
+
+    parameters = {
+      commands = [%[2]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName, command))
+}
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	automationExecutionSuccessStatuses = []awstypes.AutomationExecutionStatus{
+		awstypes.AutomationExecutionStatusSuccess,
+		awstypes.AutomationExecutionStatusCompletedWithSuccess,
+	}
+	automationExecutionFailureStatuses = []awstypes.AutomationExecutionStatus{
+		awstypes.AutomationExecutionStatusCancelled,
+		awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected,
+		awstypes.AutomationExecutionStatusCompletedWithFailure,
+		awstypes.AutomationExecutionStatusExited,
+		awstypes.AutomationExecutionStatusFailed,
+		awstypes.AutomationExecutionStatusRejected,
+		awstypes.AutomationExecutionStatusTimedout,
+	}
+	automationExecutionTransitionalStatuses = []awstypes.AutomationExecutionStatus{
+		awstypes.AutomationExecutionStatusApproved,
+		awstypes.AutomationExecutionStatusCancelling,
+		awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved,
+		awstypes.AutomationExecutionStatusInprogress,
+		awstypes.AutomationExecutionStatusPending,
+		awstypes.AutomationExecutionStatusPendingApproval,
+		awstypes.AutomationExecutionStatusPendingChangeCalendarOverride,
+		awstypes.AutomationExecutionStatusRunbookInprogress,
+		awstypes.AutomationExecutionStatusScheduled,
+		awstypes.AutomationExecutionStatusWaiting,
+	}
+)
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionActionModel]
+}
+
+type startAutomationExecutionActionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                               `tfsdk:"document_name"`
+	DocumentVersion     types.String                                               `tfsdk:"document_version"`
+	MaxConcurrency      types.String                                               `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                               `tfsdk:"max_errors"`
+	Parameters          types.Map                                                  `tfsdk:"parameters"`
+	TargetParameterName types.String                                               `tfsdk:"target_parameter_name"`
+	Targets             fwtypes.ListNestedObjectValueOf[documentActionTargetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                                `tfsdk:"timeout"`
+}
+
+// automationExecutionStatus is the state of an automation execution and, for
+// rate-controlled executions, its per-target child executions.
+type automationExecutionStatus struct {
+	execution *awstypes.AutomationExecution
+	children  []awstypes.AutomationExecutionMetadata
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := documentActionSchemaAttributes("execute")
+	attributes["target_parameter_name"] = schema.StringAttribute{
+		Description: "Name of the document parameter that receives each target's resource ID in rate-controlled executions. Required if targets is specified",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook execution and waits for it to complete. When targets are specified, the runbook runs once per target in a rate-controlled execution.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"targets": documentActionTargetsBlock(ctx),
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+	timeout := documentActionTimeout(config.Timeout)
+
+	input := ssm.StartAutomationExecutionInput{
+		DocumentName:        aws.String(documentName),
+		DocumentVersion:     fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		MaxConcurrency:      fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:           fwflex.StringFromFramework(ctx, config.MaxErrors),
+		TargetParameterName: fwflex.StringFromFramework(ctx, config.TargetParameterName),
+	}
+
+	parameters, diags := expandDocumentActionParameters(ctx, config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	targets, diags := expandDocumentActionTargets(ctx, config.Targets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+	input.Targets = targets
+
+	if len(input.Targets) > 0 && input.TargetParameterName == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"target_parameter_name must be specified when targets are specified",
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		"targets":         targetsString(input.Targets),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting SSM Automation execution of %s...", documentName),
+	})
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start SSM Automation execution of %s: %s", documentName, err),
+		)
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start SSM Automation execution of %s: %s", documentName, tfresource.NewEmptyResultError(&input)),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+	rateControlled := len(input.Targets) > 0
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s started, waiting for completion...", executionID),
+	})
+
+	reporter := newTargetStatusReporter(resp)
+	reportStatus := func(status automationExecutionStatus) {
+		if rateControlled {
+			for _, v := range status.children {
+				reporter.report(aws.ToString(v.Target), string(v.AutomationExecutionStatus), aws.ToString(v.FailureMessage))
+			}
+			return
+		}
+		if status.execution != nil {
+			for _, v := range status.execution.StepExecutions {
+				reporter.report("Step "+aws.ToString(v.StepName), string(v.StepStatus), aws.ToString(v.FailureMessage))
+			}
+		}
+	}
+
+	var successStates, transitionalStates, failureStates []actionwait.Status
+	for _, v := range automationExecutionSuccessStatuses {
+		successStates = append(successStates, actionwait.Status(v))
+	}
+	for _, v := range automationExecutionTransitionalStatuses {
+		transitionalStates = append(transitionalStates, actionwait.Status(v))
+	}
+	for _, v := range automationExecutionFailureStatuses {
+		failureStates = append(failureStates, actionwait.Status(v))
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[automationExecutionStatus], error) {
+		execution, err := findAutomationExecutionByID(ctx, conn, executionID)
+		if err != nil {
+			return actionwait.FetchResult[automationExecutionStatus]{}, fmt.Errorf("reading automation execution: %w", err)
+		}
+
+		value := automationExecutionStatus{execution: execution}
+		if rateControlled {
+			value.children, err = findAutomationExecutionsByParentID(ctx, conn, executionID)
+			if err != nil {
+				return actionwait.FetchResult[automationExecutionStatus]{}, fmt.Errorf("listing child automation executions: %w", err)
+			}
+		}
+
+		return actionwait.FetchResult[automationExecutionStatus]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: value}, nil
+	}, actionwait.Options[automationExecutionStatus]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(documentActionPollInterval),
+		ProgressInterval:   documentActionPollInterval,
+		SuccessStates:      successStates,
+		TransitionalStates: transitionalStates,
+		FailureStates:      failureStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if status, ok := fr.Value.(automationExecutionStatus); ok {
+				reportStatus(status)
+			}
+		},
+	})
+	reportStatus(result.Value)
+
+	// An execution can succeed overall while individual steps or targets fail, e.g. when a failed step is not
+	// critical (isCritical: false) or when max_errors allows failed targets.
+	failures := automationExecutionFailures(result.Value)
+
+	if err != nil {
+		detail := fmt.Sprintf("SSM Automation execution %s (%s) finished with status %s", executionID, documentName, result.Status)
+		if len(failures) > 0 {
+			detail += ":\n\n" + strings.Join(failures, "\n\n")
+		}
+		addDocumentActionWaitError(resp, err, fmt.Sprintf("SSM Automation Execution %s", executionID), timeout, detail)
+		return
+	}
+
+	if len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			"SSM Automation Execution Failed",
+			fmt.Sprintf("SSM Automation execution %s (%s) finished with status %s, but %d step(s) or target(s) failed:\n\n%s", executionID, documentName, result.Status, len(failures), strings.Join(failures, "\n\n")),
+		)
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s completed successfully", executionID),
+	})
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": executionID,
+		"document_name":           documentName,
+	})
+}
+
+// automationExecutionFailures returns a description of each failed step or, for
+// rate-controlled executions, each failed target.
+func automationExecutionFailures(status automationExecutionStatus) []string {
+	var failures []string
+
+	if execution := status.execution; execution != nil {
+		if v := aws.ToString(execution.FailureMessage); v != "" {
+			failures = append(failures, v)
+		}
+
+		for _, step := range execution.StepExecutions {
+			if !slices.Contains(automationExecutionFailureStatuses, step.StepStatus) {
+				continue
+			}
+
+			var sb strings.Builder
+			fmt.Fprintf(&sb, "Step %s: %s", aws.ToString(step.StepName), step.StepStatus)
+			if v := aws.ToString(step.FailureMessage); v != "" {
+				fmt.Fprintf(&sb, "\n%s", v)
+			}
+			for _, k := range slices.Sorted(maps.Keys(step.Outputs)) {
+				fmt.Fprintf(&sb, "\n  %s: %s", k, strings.Join(step.Outputs[k], ", "))
+			}
+			failures = append(failures, sb.String())
+		}
+	}
+
+	for _, child := range status.children {
+		if !slices.Contains(automationExecutionFailureStatuses, child.AutomationExecutionStatus) {
+			continue
+		}
+
+		failures = append(failures, fmt.Sprintf("%s (%s): %s %s", aws.ToString(child.Target), aws.ToString(child.AutomationExecutionId), child.AutomationExecutionStatus, aws.ToString(child.FailureMessage)))
+	}
+
+	return failures
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AutomationExecution, nil
+}
+
+func findAutomationExecutionsByParentID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.AutomationExecutionMetadata, error) {
+	input := ssm.DescribeAutomationExecutionsInput{
+		Filters: []awstypes.AutomationExecutionFilter{
+			{
+				Key:    awstypes.AutomationExecutionFilterKeyParentExecutionId,
+				Values: []string{id},
+			},
+		},
+	}
+
+	return findAutomationExecutions(ctx, conn, &input)
+}
+
+func findAutomationExecutions(ctx context.Context, conn *ssm.Client, input *ssm.DescribeAutomationExecutionsInput) ([]awstypes.AutomationExecutionMetadata, error) {
+	var output []awstypes.AutomationExecutionMetadata
+
+	pages := ssm.NewDescribeAutomationExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationExecutionMetadataList...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMStartAutomationExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssm_document.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_basic(rName, `print("hello")`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					testAccCheckAutomationExecutionSucceeded(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMStartAutomationExecutionAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartAutomationExecutionActionConfig_basic(rName, `raise Exception("boom")`),
+				ExpectError: regexache.MustCompile(`Step[\s\n]*run:[\s\n]*Failed`),
+			},
+		},
+	})
+}
+
+func TestAccSSMStartAutomationExecutionAction_nonCriticalStepFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartAutomationExecutionActionConfig_nonCriticalStep(rName),
+				ExpectError: regexache.MustCompile(`Step[\s\n]*optional:[\s\n]*Failed`),
+			},
+		},
+	})
+}
+
+// testAccCheckAutomationExecutionSucceeded verifies that an execution of the specified automation document succeeded.
+func testAccCheckAutomationExecutionSucceeded(ctx context.Context, documentName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.DescribeAutomationExecutionsInput{
+			Filters: []awstypes.AutomationExecutionFilter{
+				{
+					Key:    awstypes.AutomationExecutionFilterKeyDocumentNamePrefix,
+					Values: []string{documentName},
+				},
+			},
+		}
+		output, err := conn.DescribeAutomationExecutions(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.AutomationExecutionMetadataList); got != 1 {
+			return fmt.Errorf("SSM Document %s has %d automation executions, expected 1", documentName, got)
+		}
+
+		if execution := output.AutomationExecutionMetadataList[0]; execution.AutomationExecutionStatus != awstypes.AutomationExecutionStatusSuccess {
+			return fmt.Errorf("SSM Automation execution %s status is %s, expected %s", aws.ToString(execution.AutomationExecutionId), execution.AutomationExecutionStatus, awstypes.AutomationExecutionStatusSuccess)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartAutomationExecutionActionConfig_basic(rName, script string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+description: Test automation document
+parameters:
+  Message:
+    type: String
+    default: hello
+mainSteps:
+  - name: run
+    action: aws:executeScript
+    inputs:
+      Runtime: python3.11
+      Handler: handler
+      InputPayload:
+        Message: '{{ Message }}'
+      Script: |-
+        def handler(events, context):
+          %[2]s
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+
+    parameters = {
+      Message = [%[1]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ssm_document.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName, script)
+}
+
+func testAccStartAutomationExecutionActionConfig_nonCriticalStep(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+description: Test automation document
+mainSteps:
+  - name: optional
+    action: aws:executeScript
+    isCritical: false
+    onFailure: Continue
+    inputs:
+      Runtime: python3.11
+      Handler: handler
+      Script: |-
+        def handler(events, context):
+          raise Exception("boom")
+  - name: run
+    action: aws:sleep
+    inputs:
+      Duration: PT1S
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ssm_document.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
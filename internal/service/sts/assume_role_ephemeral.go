@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Resource Assume Role"
+)
+
+const (
+	assumeRoleDurationMin = 15 * time.Minute
+	assumeRoleDurationMax = 12 * time.Hour
+)
+
+// @EphemeralResource(aws_sts_assume_role, name="Assume Role")
+func newAssumeRoleEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleEphemeralResourceModel]
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration": schema.StringAttribute{
+				CustomType: fwtypes.DurationType,
+				Optional:   true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:\/\-]*$`), "must contain only alphanumeric characters, underscores and any of +=,.@:/-"),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), "must contain only alphanumeric characters, underscores and any of +=,.@-"),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_tags": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), "must contain only alphanumeric characters, underscores and any of +=,.@-"),
+				},
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	data := assumeRoleEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := sts.AssumeRoleInput{
+		RoleArn:           data.RoleARN.ValueStringPointer(),
+		RoleSessionName:   data.RoleSessionName.ValueStringPointer(),
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+
+	if !data.Duration.IsNull() {
+		duration := data.Duration.ValueDuration()
+		if duration < assumeRoleDurationMin || duration > assumeRoleDurationMax {
+			response.Diagnostics.AddAttributeError(
+				path.Root("duration"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("duration must be between %s and %s, got: %s", assumeRoleDurationMin, assumeRoleDurationMax, duration),
+			)
+			return
+		}
+		input.DurationSeconds = aws.Int32(int32(duration.Seconds()))
+	}
+
+	if !data.Policy.IsNull() {
+		input.Policy = data.Policy.ValueStringPointer()
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.SessionTags) {
+		input.Tags = append(input.Tags, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRole, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if output.Credentials == nil {
+		err := fmt.Errorf("no credentials returned")
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionReading, ERNameAssumeRole, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	credentials := output.Credentials
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(credentials.Expiration)
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	Duration          fwtypes.Duration    `tfsdk:"duration"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	RoleSessionName   types.String        `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key"`
+	SessionTags       fwtypes.MapOfString `tfsdk:"session_tags"`
+	SessionToken      types.String        `tfsdk:"session_token"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
@@ -10,14 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	sweep.AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := sweep.Context(region)
 			ctx = log.WithResourceType(ctx, name)
+			ctx = report.WithResourceType(ctx, name)
 
 			client, err := sweep.SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -27,15 +29,19 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 			sweepResources, err := f(ctx, client)
 
 			if SkipSweepError(err) {
-				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
-					"error": err.Error(),
-				})
+				sweep.SkipSweeper(ctx, err)
 				return nil
 			}
 			if err != nil {
 				return fmt.Errorf("listing %q (%s): %w", name, region, err)
 			}
 
+			for i, v := range sweepResources {
+				if sweep.ResourceTypeOf(v) == "" {
+					sweepResources[i] = sweep.WithResourceType(name, v)
+				}
+			}
+
 			err = sweep.SweepOrchestrator(ctx, sweepResources)
 			if err != nil {
 				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
 )
 
 func Context(region string) context.Context {
@@ -16,6 +17,7 @@ func Context(region string) context.Context {
 	ctx = tfsdklog.RegisterStdlogSink(ctx)
 
 	ctx = log.Logger(ctx, "sweeper", region)
+	ctx = report.WithRegion(ctx, region)
 
 	return ctx
 }
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+)
+
+// registry is the registry of resource type dependencies and of the sweepers added with AddTestSweepers.
+var registry = newSweeperRegistry()
+
+type sweeperRegistry struct {
+	sync.Mutex
+	dependencies map[string][]string          // Resource type -> the resource types it depends on.
+	sweepers     map[string]*resource.Sweeper // Resource type -> sweeper.
+}
+
+func newSweeperRegistry() *sweeperRegistry {
+	return &sweeperRegistry{
+		dependencies: make(map[string][]string),
+		sweepers:     make(map[string]*resource.Sweeper),
+	}
+}
+
+// addDependencies records that resources of the specified type depend on resources of the dependency types
+// and adds the resource type's sweeper to the dependencies of each dependency type's sweeper.
+func (r *sweeperRegistry) addDependencies(resourceType string, dependencies ...string) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, dependency := range dependencies {
+		if !slices.Contains(r.dependencies[resourceType], dependency) {
+			r.dependencies[resourceType] = append(r.dependencies[resourceType], dependency)
+		}
+		r.addSweeperDependency(dependency, resourceType)
+	}
+}
+
+// addSweeper records the sweeper for the specified resource type and adds the registered dependencies
+// between its resource type and those of the other recorded sweepers.
+func (r *sweeperRegistry) addSweeper(resourceType string, sweeper *resource.Sweeper) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.sweepers[resourceType] = sweeper
+
+	for dependent, dependencies := range r.dependencies {
+		if slices.Contains(dependencies, resourceType) {
+			r.addSweeperDependency(resourceType, dependent)
+		}
+	}
+	for _, dependency := range r.dependencies[resourceType] {
+		r.addSweeperDependency(dependency, resourceType)
+	}
+}
+
+// addSweeperDependency adds the dependent resource type's sweeper to the dependencies of the resource type's sweeper,
+// so that the dependent resources are swept first.
+// Nothing is added unless both sweepers are recorded, as a sweeper with a missing dependency fails to run.
+func (r *sweeperRegistry) addSweeperDependency(resourceType, dependent string) {
+	sweeper, ok := r.sweepers[resourceType]
+	if !ok {
+		return
+	}
+	if _, ok := r.sweepers[dependent]; !ok {
+		return
+	}
+
+	if !slices.Contains(sweeper.Dependencies, dependent) {
+		sweeper.Dependencies = append(sweeper.Dependencies, dependent)
+	}
+}
+
+func (r *sweeperRegistry) resourceTypeDependencies() map[string][]string {
+	r.Lock()
+	defer r.Unlock()
+
+	return maps.Clone(r.dependencies)
+}
+
+// RegisterResourceTypeDependencies declares that resources of the specified type depend on resources of the dependency types.
+// Resources of a type are swept before any resources of the types that it depends on.
+// For example, `aws_subnet` depends on `aws_vpc`, so subnets are swept before VPCs.
+//
+// Between sweepers, the dependencies are added to the Dependencies of sweepers added with AddTestSweepers
+// (including those registered with awsv2.Register), whose names are their resource types.
+// Within a single SweepOrchestrator call, resources are swept in waves by their resource type (see WithResourceType).
+func RegisterResourceTypeDependencies(resourceType string, dependencies ...string) {
+	registry.addDependencies(resourceType, dependencies...)
+}
+
+// AddTestSweepers adds a sweeper, as resource.AddTestSweepers does.
+// The sweeper's name must be the resource type that it sweeps.
+// The sweepers of resource types registered with RegisterResourceTypeDependencies as depending on the sweeper's
+// resource type are added to its Dependencies, whether they are registered before or after it.
+// Sweepers added directly with resource.AddTestSweepers don't take part in resource type dependencies.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	registry.addSweeper(name, s)
+	resource.AddTestSweepers(name, s)
+}
+
+// ResourceTyped is implemented by Sweepables that know their resource type.
+type ResourceTyped interface {
+	ResourceType() string
+}
+
+type resourceTypeSweepable struct {
+	Sweepable
+	resourceType string
+}
+
+func (s resourceTypeSweepable) ResourceType() string {
+	return s.resourceType
+}
+
+func (s resourceTypeSweepable) Unwrap() Sweepable {
+	return s.Sweepable
+}
+
+func (s resourceTypeSweepable) Describe(ctx context.Context) report.Resource {
+	r := describe(ctx, s.Sweepable)
+	r.ResourceType = s.resourceType
+
+	return r
+}
+
+// WithResourceType returns a Sweepable that reports the specified resource type.
+// Resource types are used to sweep resources in dependency order.
+func WithResourceType(resourceType string, sweepable Sweepable) Sweepable {
+	return resourceTypeSweepable{
+		Sweepable:    sweepable,
+		resourceType: resourceType,
+	}
+}
+
+// ResourceTypeOf returns the resource type of the specified Sweepable, or "" if it is not known.
+func ResourceTypeOf(sweepable Sweepable) string {
+	if v, ok := sweepable.(ResourceTyped); ok {
+		return v.ResourceType()
+	}
+
+	return ""
+}
+
+// sweepWaves groups the specified Sweepables into waves that can be swept in order.
+// Within a wave, resources can be swept concurrently. A resource type's resources are all in an earlier wave than
+// the resources of the types that it depends on (directly or transitively).
+// Sweepables with no resource type are in the first wave.
+// Returns an error if a dependency cycle is detected.
+func sweepWaves(sweepables []Sweepable, dependencies map[string][]string) ([][]Sweepable, error) {
+	byType := make(map[string][]Sweepable)
+	for _, sweepable := range sweepables {
+		resourceType := ResourceTypeOf(sweepable)
+		byType[resourceType] = append(byType[resourceType], sweepable)
+	}
+
+	if len(byType) <= 1 {
+		return [][]Sweepable{sweepables}, nil
+	}
+
+	g := depgraph.New()
+	// Add nodes in a deterministic order so that the overall order is stable.
+	for _, resourceType := range slices.Sorted(maps.Keys(dependencies)) {
+		g.AddNode(resourceType)
+		for _, dependency := range dependencies[resourceType] {
+			g.AddNode(dependency)
+		}
+	}
+	for resourceType := range byType {
+		if resourceType != "" {
+			g.AddNode(resourceType)
+		}
+	}
+	for resourceType, v := range dependencies {
+		for _, dependency := range v {
+			if err := g.AddDependency(resourceType, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	// The overall order has each resource type after all of its dependencies.
+	// Walk it backwards so that a resource type's dependents have been assigned a wave before it is.
+	waveOf := make(map[string]int, len(order))
+	var n int
+	for _, resourceType := range slices.Backward(order) {
+		dependents, err := g.DirectDependentsOf(resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		var wave int
+		for _, dependent := range dependents {
+			wave = max(wave, waveOf[dependent]+1)
+		}
+		waveOf[resourceType] = wave
+		n = max(n, wave+1)
+	}
+
+	waves := make([][]Sweepable, n)
+	for resourceType, v := range byType {
+		wave := waveOf[resourceType] // Untyped Sweepables are in the first wave.
+		waves[wave] = append(waves[wave], v...)
+	}
+
+	// Resource types that don't have any resources to sweep leave empty waves.
+	return slices.DeleteFunc(waves, func(v []Sweepable) bool {
+		return len(v) == 0
+	}), nil
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id string
+}
+
+func (s testSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func TestSweepWaves(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_network_interface": {"aws_subnet"},
+		"aws_subnet":            {"aws_vpc"},
+		"aws_instance":          {"aws_subnet", "aws_key_pair"},
+	}
+
+	testCases := map[string]struct {
+		sweepables   []Sweepable
+		dependencies map[string][]string
+		expected     [][]string
+		expectError  bool
+	}{
+		"empty": {
+			expected: [][]string{{}},
+		},
+		"single type": {
+			sweepables: []Sweepable{
+				WithResourceType("aws_vpc", testSweepable{"vpc-1"}),
+				WithResourceType("aws_vpc", testSweepable{"vpc-2"}),
+			},
+			dependencies: dependencies,
+			expected:     [][]string{{"vpc-1", "vpc-2"}},
+		},
+		"untyped": {
+			sweepables: []Sweepable{
+				testSweepable{"a"},
+				testSweepable{"b"},
+			},
+			dependencies: dependencies,
+			expected:     [][]string{{"a", "b"}},
+		},
+		"chain": {
+			sweepables: []Sweepable{
+				WithResourceType("aws_vpc", testSweepable{"vpc-1"}),
+				WithResourceType("aws_subnet", testSweepable{"subnet-1"}),
+				WithResourceType("aws_network_interface", testSweepable{"eni-1"}),
+				WithResourceType("aws_subnet", testSweepable{"subnet-2"}),
+			},
+			dependencies: dependencies,
+			expected:     [][]string{{"eni-1"}, {"subnet-1", "subnet-2"}, {"vpc-1"}},
+		},
+		"transitive through missing type": {
+			sweepables: []Sweepable{
+				WithResourceType("aws_vpc", testSweepable{"vpc-1"}),
+				WithResourceType("aws_network_interface", testSweepable{"eni-1"}),
+			},
+			dependencies: dependencies,
+			expected:     [][]string{{"eni-1"}, {"vpc-1"}},
+		},
+		"independent types": {
+			sweepables: []Sweepable{
+				WithResourceType("aws_key_pair", testSweepable{"key-1"}),
+				WithResourceType("aws_instance", testSweepable{"i-1"}),
+				WithResourceType("aws_network_interface", testSweepable{"eni-1"}),
+				WithResourceType("aws_vpc", testSweepable{"vpc-1"}),
+				testSweepable{"untyped"},
+			},
+			dependencies: dependencies,
+			expected:     [][]string{{"eni-1", "i-1", "untyped"}, {"key-1"}, {"vpc-1"}},
+		},
+		"cycle": {
+			sweepables: []Sweepable{
+				WithResourceType("a", testSweepable{"a-1"}),
+				WithResourceType("b", testSweepable{"b-1"}),
+			},
+			dependencies: map[string][]string{
+				"a": {"b"},
+				"b": {"a"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			waves, err := sweepWaves(testCase.sweepables, testCase.dependencies)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("expected error %t, got %v", want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			got := make([][]string, len(waves))
+			for i, wave := range waves {
+				got[i] = make([]string, 0, len(wave))
+				for _, v := range wave {
+					switch v := v.(type) {
+					case resourceTypeSweepable:
+						got[i] = append(got[i], v.Sweepable.(testSweepable).id)
+					case testSweepable:
+						got[i] = append(got[i], v.id)
+					}
+				}
+				slices.Sort(got[i])
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperRegistry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		register func(r *sweeperRegistry, sweepers map[string]*resource.Sweeper)
+	}{
+		"dependencies first": {
+			register: func(r *sweeperRegistry, sweepers map[string]*resource.Sweeper) {
+				r.addDependencies("aws_network_interface", "aws_subnet")
+				r.addDependencies("aws_subnet", "aws_vpc")
+				r.addSweeper("aws_vpc", sweepers["aws_vpc"])
+				r.addSweeper("aws_subnet", sweepers["aws_subnet"])
+				r.addSweeper("aws_network_interface", sweepers["aws_network_interface"])
+			},
+		},
+		"sweepers first": {
+			register: func(r *sweeperRegistry, sweepers map[string]*resource.Sweeper) {
+				r.addSweeper("aws_network_interface", sweepers["aws_network_interface"])
+				r.addSweeper("aws_subnet", sweepers["aws_subnet"])
+				r.addSweeper("aws_vpc", sweepers["aws_vpc"])
+				r.addDependencies("aws_subnet", "aws_vpc")
+				r.addDependencies("aws_network_interface", "aws_subnet")
+			},
+		},
+		"interleaved": {
+			register: func(r *sweeperRegistry, sweepers map[string]*resource.Sweeper) {
+				r.addSweeper("aws_subnet", sweepers["aws_subnet"])
+				r.addDependencies("aws_network_interface", "aws_subnet")
+				r.addSweeper("aws_vpc", sweepers["aws_vpc"])
+				r.addDependencies("aws_subnet", "aws_vpc")
+				r.addSweeper("aws_network_interface", sweepers["aws_network_interface"])
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var swept []string
+			sweepers := make(map[string]*resource.Sweeper)
+			for _, name := range []string{"aws_network_interface", "aws_subnet", "aws_vpc"} {
+				sweepers[name] = &resource.Sweeper{
+					Name: name,
+					F: func(region string) error {
+						swept = append(swept, name)
+						return nil
+					},
+				}
+			}
+			// An existing dependency is kept.
+			sweepers["aws_vpc"].Dependencies = []string{"aws_internet_gateway"}
+
+			r := newSweeperRegistry()
+			testCase.register(r, sweepers)
+
+			if diff := cmp.Diff(sweepers["aws_vpc"].Dependencies, []string{"aws_internet_gateway", "aws_subnet"}); diff != "" {
+				t.Errorf("unexpected aws_vpc dependencies diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(sweepers["aws_subnet"].Dependencies, []string{"aws_network_interface"}); diff != "" {
+				t.Errorf("unexpected aws_subnet dependencies diff (+wanted, -got): %s", diff)
+			}
+			if got := sweepers["aws_network_interface"].Dependencies; len(got) != 0 {
+				t.Errorf("unexpected aws_network_interface dependencies: %v", got)
+			}
+
+			// Run the sweepers the way that the sweeper test harness does: each sweeper's dependencies first.
+			sweepers["aws_internet_gateway"] = &resource.Sweeper{
+				Name: "aws_internet_gateway",
+				F: func(region string) error {
+					swept = append(swept, "aws_internet_gateway")
+					return nil
+				},
+			}
+			ran := make(map[string]bool)
+			var run func(s *resource.Sweeper)
+			run = func(s *resource.Sweeper) {
+				for _, dependency := range s.Dependencies {
+					run(sweepers[dependency])
+				}
+				if !ran[s.Name] {
+					ran[s.Name] = true
+					s.F("us-west-2") //nolint:errcheck // Test sweepers always succeed.
+				}
+			}
+			run(sweepers["aws_vpc"])
+
+			if diff := cmp.Diff(swept, []string{"aws_internet_gateway", "aws_network_interface", "aws_subnet", "aws_vpc"}); diff != "" {
+				t.Errorf("unexpected sweep order diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperRegistry_missingSweeper(t *testing.T) {
+	t.Parallel()
+
+	vpc := &resource.Sweeper{Name: "aws_vpc"}
+
+	r := newSweeperRegistry()
+	r.addDependencies("aws_subnet", "aws_vpc")
+	r.addSweeper("aws_vpc", vpc)
+
+	// The aws_subnet sweeper isn't recorded, so it mustn't be added as a dependency that would fail to run.
+	if got := vpc.Dependencies; len(got) != 0 {
+		t.Errorf("unexpected aws_vpc dependencies: %v", got)
+	}
+
+	if diff := cmp.Diff(r.resourceTypeDependencies(), map[string][]string{"aws_subnet": {"aws_vpc"}}); diff != "" {
+		t.Errorf("unexpected resource type dependencies diff (+wanted, -got): %s", diff)
+	}
+}
+
+type testOrderSweepable struct {
+	id      string
+	mu      *sync.Mutex
+	deleted *[]string
+}
+
+func (s testOrderSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	*s.deleted = append(*s.deleted, s.id)
+
+	return nil
+}
+
+func TestSweepOrchestrator_dependencyOrder(t *testing.T) {
+	// Resource types that no real sweeper uses, as the registry is shared.
+	RegisterResourceTypeDependencies("test_sweep_order_child", "test_sweep_order_parent")
+	RegisterResourceTypeDependencies("test_sweep_order_parent", "test_sweep_order_grandparent")
+
+	var mu sync.Mutex
+	var deleted []string
+	sweepable := func(resourceType, id string) Sweepable {
+		return WithResourceType(resourceType, testOrderSweepable{id: id, mu: &mu, deleted: &deleted})
+	}
+
+	sweepables := []Sweepable{
+		sweepable("test_sweep_order_grandparent", "grandparent-1"),
+		sweepable("test_sweep_order_parent", "parent-1"),
+		sweepable("test_sweep_order_child", "child-1"),
+		sweepable("test_sweep_order_parent", "parent-2"),
+		sweepable("test_sweep_order_child", "child-2"),
+	}
+
+	if err := SweepOrchestrator(t.Context(), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(deleted), len(sweepables); got != want {
+		t.Fatalf("deleted %d resources, want %d", got, want)
+	}
+
+	// Resources are deleted concurrently within a wave, so compare each wave's resources regardless of order.
+	got := [][]string{slices.Sorted(slices.Values(deleted[0:2])), slices.Sorted(slices.Values(deleted[2:4])), deleted[4:]}
+	want := [][]string{{"child-1", "child-2"}, {"parent-1", "parent-2"}, {"grandparent-1"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected sweep order diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+)
+
+const (
+	// getResourcesMaxARNs is the maximum number of ARNs in a single GetResources request.
+	getResourcesMaxARNs = 100
+)
+
+const (
+	// Reasons for skipping resources whose metadata the sweeper doesn't provide.
+	reasonCreationTimeUnknown = "creation time unknown"
+	reasonTagsUnknown         = "tags unknown"
+)
+
+// Metadata is information about a resource that sweepers can provide for filtering.
+// Any unknown values are left empty.
+type Metadata struct {
+	ARN          string
+	CreationTime time.Time
+	Tags         map[string]string // If nil, tags are looked up using the Resource Groups Tagging API.
+}
+
+type metadataSweepable struct {
+	Sweepable
+	metadata Metadata
+}
+
+func (s metadataSweepable) Unwrap() Sweepable {
+	return s.Sweepable
+}
+
+func (s metadataSweepable) Describe(ctx context.Context) report.Resource {
+	return describe(ctx, s.Sweepable)
+}
+
+func (s metadataSweepable) ResourceType() string {
+	return ResourceTypeOf(s.Sweepable)
+}
+
+// WithMetadata returns a Sweepable with the specified metadata, used to filter the resources to sweep.
+func WithMetadata(sweepable Sweepable, metadata Metadata) Sweepable {
+	return metadataSweepable{
+		Sweepable: sweepable,
+		metadata:  metadata,
+	}
+}
+
+// metadataOf returns what is known about the specified Sweepable's resource.
+func metadataOf(sweepable Sweepable) Metadata {
+	var metadata Metadata
+
+	for s := sweepable; s != nil; {
+		if v, ok := s.(metadataSweepable); ok {
+			if metadata.ARN == "" {
+				metadata.ARN = v.metadata.ARN
+			}
+			if metadata.CreationTime.IsZero() {
+				metadata.CreationTime = v.metadata.CreationTime
+			}
+			if metadata.Tags == nil {
+				metadata.Tags = v.metadata.Tags
+			}
+		}
+
+		// Sweepables implemented in this module may also know their resource's ARN and creation time.
+		if v, ok := s.(interface{ ARN() string }); ok && metadata.ARN == "" {
+			metadata.ARN = v.ARN()
+		}
+		if v, ok := s.(interface{ CreationTime() time.Time }); ok && metadata.CreationTime.IsZero() {
+			metadata.CreationTime = v.CreationTime()
+		}
+
+		if v, ok := s.(interface{ Unwrap() Sweepable }); ok {
+			s = v.Unwrap()
+		} else {
+			s = nil
+		}
+	}
+
+	return metadata
+}
+
+// metadataReader is implemented by Sweepables that can read their resource to find out its ARN and creation time.
+type metadataReader interface {
+	// ReadMetadata reads the resource. It returns false if the resource no longer exists.
+	ReadMetadata(ctx context.Context) (bool, error)
+}
+
+// metadataReaderOf returns the metadataReader wrapped by the specified Sweepable, if any.
+func metadataReaderOf(sweepable Sweepable) (metadataReader, bool) {
+	for s := sweepable; s != nil; {
+		if v, ok := s.(metadataReader); ok {
+			return v, true
+		}
+
+		if v, ok := s.(interface{ Unwrap() Sweepable }); ok {
+			s = v.Unwrap()
+		} else {
+			s = nil
+		}
+	}
+
+	return nil, false
+}
+
+// sweepFilter restricts sweeping to resources with specific tags or older than a minimum age.
+type sweepFilter struct {
+	tags   map[string]string // Tag key -> value. An empty value matches any value.
+	minAge time.Duration
+}
+
+// sweepFilterFromEnv returns the operator-configured sweep filter, or nil if none is configured.
+func sweepFilterFromEnv() (*sweepFilter, error) {
+	var filter sweepFilter
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		filter.tags = make(map[string]string)
+
+		for tag := range strings.SplitSeq(v, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(tag), "=")
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: invalid tag %q", envvar.SweepTags, tag)
+			}
+			filter.tags[key] = value
+		}
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		filter.minAge = d
+	}
+
+	if len(filter.tags) == 0 && filter.minAge == 0 {
+		return nil, nil
+	}
+
+	return &filter, nil
+}
+
+// lookupTagsFunc returns the tags of the resources with the specified ARNs.
+// Resources that have no tags, or that aren't supported, are omitted.
+type lookupTagsFunc func(ctx context.Context, arns []string) (map[string]map[string]string, error)
+
+// needsRead returns whether the resource's ARN or creation time must be read to apply the filter.
+func (f *sweepFilter) needsRead(metadata Metadata) bool {
+	return (len(f.tags) > 0 && metadata.Tags == nil && metadata.ARN == "") || (f.minAge > 0 && metadata.CreationTime.IsZero())
+}
+
+// apply returns the Sweepables that pass the filter.
+// Resources whose tags or age are needed but aren't provided by the sweeper are read, if the Sweepable supports it.
+// Resources whose tags or age still can't be determined are skipped.
+func (f *sweepFilter) apply(ctx context.Context, sweepables []Sweepable, lookupTags lookupTagsFunc, now time.Time) ([]Sweepable, error) {
+	metadata := make([]Metadata, len(sweepables))
+	reasons := make([]string, len(sweepables))
+	var arns []string
+
+	for i, sweepable := range sweepables {
+		metadata[i] = metadataOf(sweepable)
+
+		if f.needsRead(metadata[i]) {
+			if v, ok := metadataReaderOf(sweepable); ok {
+				exists, err := v.ReadMetadata(ctx)
+
+				switch {
+				case err != nil:
+					reasons[i] = fmt.Sprintf("reading resource: %s", err)
+				case !exists:
+					reasons[i] = "not found"
+				default:
+					metadata[i] = metadataOf(sweepable)
+				}
+			}
+		}
+
+		if reasons[i] == "" && len(f.tags) > 0 && metadata[i].Tags == nil && metadata[i].ARN != "" {
+			arns = append(arns, metadata[i].ARN)
+		}
+	}
+
+	var tagsByARN map[string]map[string]string
+	if len(arns) > 0 {
+		var err error
+		tagsByARN, err = lookupTags(ctx, arns)
+		if err != nil {
+			return nil, fmt.Errorf("looking up tags: %w", err)
+		}
+	}
+
+	var result []Sweepable
+	var unknown int
+	for i, sweepable := range sweepables {
+		reason := reasons[i]
+		if reason == "" {
+			reason = f.reject(metadata[i], tagsByARN, now)
+		}
+
+		if reason != "" {
+			if reason == reasonTagsUnknown || reason == reasonCreationTimeUnknown {
+				unknown++
+			}
+
+			r := describe(ctx, sweepable)
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"id":     r.ID,
+				"reason": reason,
+			})
+			report.AddSkipped(r, reason)
+
+			continue
+		}
+
+		result = append(result, sweepable)
+	}
+
+	// Make it obvious when a sweeper can't be filtered, rather than it quietly sweeping nothing.
+	if unknown > 0 {
+		tflog.Warn(ctx, "Skipping resources whose tags or creation time are unknown; the sweeper doesn't provide them (see sweep.WithMetadata)", map[string]any{
+			"skipped_resources": unknown,
+			"resources":         len(sweepables),
+		})
+	}
+
+	return result, nil
+}
+
+// reject returns why a resource doesn't pass the filter, or "" if it does.
+func (f *sweepFilter) reject(metadata Metadata, tagsByARN map[string]map[string]string, now time.Time) string {
+	if len(f.tags) > 0 {
+		tags := metadata.Tags
+		if tags == nil {
+			if metadata.ARN == "" {
+				return reasonTagsUnknown
+			}
+			tags = tagsByARN[metadata.ARN]
+		}
+
+		for key, want := range f.tags {
+			got, ok := tags[key]
+			if !ok {
+				return fmt.Sprintf("no %q tag", key)
+			}
+			if want != "" && got != want {
+				return fmt.Sprintf("tag %q is %q", key, got)
+			}
+		}
+	}
+
+	if f.minAge > 0 {
+		if metadata.CreationTime.IsZero() {
+			return reasonCreationTimeUnknown
+		}
+		if age := now.Sub(metadata.CreationTime); age < f.minAge {
+			return fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return ""
+}
+
+// lookupTagsWithTaggingAPI looks up tags using the Resource Groups Tagging API in each resource's Region.
+func lookupTagsWithTaggingAPI(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+	defaultRegion := report.RegionFromContext(ctx)
+	arnsByRegion := make(map[string][]string)
+
+	for _, v := range arns {
+		region := defaultRegion
+		if parsed, err := arn.Parse(v); err == nil && parsed.Region != "" {
+			region = parsed.Region
+		}
+		arnsByRegion[region] = append(arnsByRegion[region], v)
+	}
+
+	result := make(map[string]map[string]string)
+
+	for region, arns := range arnsByRegion {
+		client, err := SharedRegionalSweepClient(ctx, region)
+		if err != nil {
+			return nil, err
+		}
+		conn := client.ResourceGroupsTaggingAPIClient(ctx)
+
+		for chunk := range slices.Chunk(arns, getResourcesMaxARNs) {
+			input := resourcegroupstaggingapi.GetResourcesInput{
+				ResourceARNList: chunk,
+			}
+			pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.ResourceTagMappingList {
+					tags := make(map[string]string, len(v.Tags))
+					for _, tag := range v.Tags {
+						tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+					}
+					result[aws.ToString(v.ResourceARN)] = tags
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Allowed returns whether the operator-configured sweep filter, if any, allows a resource with the specified metadata to be swept.
+// Sweepers that modify or delete resources themselves, rather than returning Sweepables to SweepOrchestrator, must check it first.
+// Tags are not looked up, so any tags must be in the metadata.
+func Allowed(ctx context.Context, metadata Metadata) bool {
+	filter, err := sweepFilterFromEnv()
+	if err != nil {
+		tflog.Warn(ctx, "Invalid sweep filter", map[string]any{
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	if filter == nil {
+		return true
+	}
+
+	return filter.reject(metadata, nil, time.Now()) == ""
+}
+
+// filterSweepables applies the operator-configured sweep filter, if any, to the specified Sweepables.
+func filterSweepables(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	filter, err := sweepFilterFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == nil || len(sweepables) == 0 {
+		return sweepables, nil
+	}
+
+	return filter.apply(ctx, sweepables, lookupTagsWithTaggingAPI, time.Now())
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSweepFilterApply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	lookupTags := func(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+		return map[string]map[string]string{
+			"arn:aws:sqs:us-west-2:123456789012:tagged":   {"CreatedBy": "acctest"}, //lintignore:AWSAT003,AWSAT005
+			"arn:aws:sqs:us-west-2:123456789012:untagged": {"CreatedBy": "someone"}, //lintignore:AWSAT003,AWSAT005
+		}, nil
+	}
+	sweepables := []Sweepable{
+		WithMetadata(testSweepable{"tagged"}, Metadata{ARN: "arn:aws:sqs:us-west-2:123456789012:tagged", CreationTime: now.Add(-48 * time.Hour)}),     //lintignore:AWSAT003,AWSAT005
+		WithMetadata(testSweepable{"untagged"}, Metadata{ARN: "arn:aws:sqs:us-west-2:123456789012:untagged", CreationTime: now.Add(-48 * time.Hour)}), //lintignore:AWSAT003,AWSAT005
+		WithResourceType("aws_subnet", WithMetadata(testSweepable{"new"}, Metadata{Tags: map[string]string{"CreatedBy": "acctest"}, CreationTime: now.Add(-time.Hour)})),
+		WithMetadata(testSweepable{"no-creation-time"}, Metadata{Tags: map[string]string{"CreatedBy": "acctest"}}),
+		testSweepable{"unknown"},
+	}
+
+	testCases := map[string]struct {
+		filter   sweepFilter
+		expected []string
+	}{
+		"tag value": {
+			filter:   sweepFilter{tags: map[string]string{"CreatedBy": "acctest"}},
+			expected: []string{"new", "no-creation-time", "tagged"},
+		},
+		"tag key": {
+			filter:   sweepFilter{tags: map[string]string{"CreatedBy": ""}},
+			expected: []string{"new", "no-creation-time", "tagged", "untagged"},
+		},
+		"min age": {
+			filter:   sweepFilter{minAge: 24 * time.Hour},
+			expected: []string{"tagged", "untagged"},
+		},
+		"tag and min age": {
+			filter:   sweepFilter{tags: map[string]string{"CreatedBy": "acctest"}, minAge: 24 * time.Hour},
+			expected: []string{"tagged"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := testCase.filter.apply(t.Context(), sweepables, lookupTags, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, v := range result {
+				for s := v; s != nil; {
+					if v, ok := s.(testSweepable); ok {
+						got = append(got, v.id)
+					}
+					if v, ok := s.(interface{ Unwrap() Sweepable }); ok {
+						s = v.Unwrap()
+					} else {
+						s = nil
+					}
+				}
+			}
+			slices.Sort(got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type testMetadataReaderSweepable struct {
+	testSweepable
+	arn          string
+	creationTime time.Time
+	exists       bool
+	err          error
+	read         bool
+}
+
+func (s *testMetadataReaderSweepable) ReadMetadata(ctx context.Context) (bool, error) {
+	s.read = true
+	return s.exists, s.err
+}
+
+func (s *testMetadataReaderSweepable) ARN() string {
+	if !s.read {
+		return ""
+	}
+	return s.arn
+}
+
+func (s *testMetadataReaderSweepable) CreationTime() time.Time {
+	if !s.read {
+		return time.Time{}
+	}
+	return s.creationTime
+}
+
+func TestSweepFilterApply_readMetadata(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	lookupTags := func(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+		return map[string]map[string]string{
+			"arn:aws:sqs:us-west-2:123456789012:old": {"CreatedBy": "acctest"}, //lintignore:AWSAT003,AWSAT005
+			"arn:aws:sqs:us-west-2:123456789012:new": {"CreatedBy": "acctest"}, //lintignore:AWSAT003,AWSAT005
+		}, nil
+	}
+
+	testCases := map[string]struct {
+		filter   sweepFilter
+		expected []string
+	}{
+		"tag value": {
+			filter:   sweepFilter{tags: map[string]string{"CreatedBy": "acctest"}},
+			expected: []string{"new", "old"},
+		},
+		"min age": {
+			filter:   sweepFilter{minAge: 24 * time.Hour},
+			expected: []string{"old"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sweepables := []Sweepable{
+				WithResourceType("aws_sqs_queue", &testMetadataReaderSweepable{testSweepable: testSweepable{"old"}, arn: "arn:aws:sqs:us-west-2:123456789012:old", creationTime: now.Add(-48 * time.Hour), exists: true}), //lintignore:AWSAT003,AWSAT005
+				&testMetadataReaderSweepable{testSweepable: testSweepable{"new"}, arn: "arn:aws:sqs:us-west-2:123456789012:new", creationTime: now.Add(-time.Hour), exists: true},                                         //lintignore:AWSAT003,AWSAT005
+				&testMetadataReaderSweepable{testSweepable: testSweepable{"gone"}},
+				&testMetadataReaderSweepable{testSweepable: testSweepable{"error"}, err: errors.New("AccessDenied")},
+			}
+
+			result, err := testCase.filter.apply(t.Context(), sweepables, lookupTags, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, v := range result {
+				for s := v; s != nil; {
+					if v, ok := s.(*testMetadataReaderSweepable); ok {
+						got = append(got, v.id)
+					}
+					if v, ok := s.(interface{ Unwrap() Sweepable }); ok {
+						s = v.Unwrap()
+					} else {
+						s = nil
+					}
+				}
+			}
+			slices.Sort(got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
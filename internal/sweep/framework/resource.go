@@ -5,8 +5,10 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -111,6 +114,56 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// ARN returns the resource's ARN, if known.
+func (sr *sweepResource) ARN() string {
+	for _, attr := range sr.attributes {
+		var v string
+		switch value := attr.value.(type) {
+		case string:
+			v = value
+		case *string:
+			v = aws.ToString(value)
+		default:
+			continue
+		}
+
+		if attr.path == names.AttrARN || (attr.path == names.AttrID && arn.IsARN(v)) {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func (sr *sweepResource) Describe(ctx context.Context) report.Resource {
+	r := report.Resource{
+		Attributes: make(map[string]string, len(sr.attributes)),
+		Region:     sr.meta.Region(ctx),
+	}
+
+	for _, attr := range sr.attributes {
+		var v string
+		switch value := attr.value.(type) {
+		case *string:
+			v = aws.ToString(value)
+
+		default:
+			v = fmt.Sprint(value)
+		}
+
+		switch attr.path {
+		case names.AttrID:
+			r.ID = v
+		case names.AttrRegion:
+			r.Region = v
+		default:
+			r.Attributes[attr.path] = v
+		}
+	}
+
+	return r
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Resource identifies a resource found by a sweeper.
+type Resource struct {
+	ResourceType string            `json:"resource_type,omitempty"`
+	ID           string            `json:"id,omitempty"`
+	Region       string            `json:"region,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	Sweepable    string            `json:"sweepable,omitempty"` // Go type of Sweepables that can't describe themselves.
+	Error        string            `json:"error,omitempty"`
+}
+
+// SkippedResource is a resource that a sweeper chose not to sweep.
+type SkippedResource struct {
+	Resource
+	Reason string `json:"reason"`
+}
+
+// SkippedSweeper is a sweeper that was skipped, for example because the service isn't available in the Region.
+type SkippedSweeper struct {
+	ResourceType string `json:"resource_type,omitempty"`
+	Region       string `json:"region,omitempty"`
+	Reason       string `json:"reason"`
+}
+
+// Report is the machine-readable report of a sweeper run.
+type Report struct {
+	DryRun          bool              `json:"dry_run"`
+	Candidates      []Resource        `json:"candidates"`
+	Skipped         []SkippedResource `json:"skipped"`
+	SkippedSweepers []SkippedSweeper  `json:"skipped_sweepers"`
+}
+
+// Describer is implemented by Sweepables that can describe the resource they sweep.
+type Describer interface {
+	Describe(ctx context.Context) Resource
+}
+
+var current = struct {
+	sync.Mutex
+	report Report
+}{
+	report: Report{
+		Candidates:      make([]Resource, 0),
+		Skipped:         make([]SkippedResource, 0),
+		SkippedSweepers: make([]SkippedSweeper, 0),
+	},
+}
+
+// DryRun returns whether sweepers are running in dry-run mode.
+// In dry-run mode, resources are reported instead of deleted.
+func DryRun() bool {
+	v, _ := strconv.ParseBool(os.Getenv(envvar.SweepDryRun))
+	return v
+}
+
+// SetDryRun records whether the sweepers' API clients are configured for dry-run mode.
+func SetDryRun(dryRun bool) {
+	current.Lock()
+	defer current.Unlock()
+
+	current.report.DryRun = dryRun
+}
+
+// AddCandidate records a resource that was, or in dry-run mode would have been, swept.
+func AddCandidate(resource Resource) {
+	current.Lock()
+	defer current.Unlock()
+
+	current.report.Candidates = append(current.report.Candidates, resource)
+}
+
+// AddSkipped records a resource that a sweeper chose not to sweep.
+func AddSkipped(resource Resource, reason string) {
+	current.Lock()
+	defer current.Unlock()
+
+	current.report.Skipped = append(current.report.Skipped, SkippedResource{
+		Resource: resource,
+		Reason:   reason,
+	})
+}
+
+// AddSkippedSweeper records a skipped sweeper.
+func AddSkippedSweeper(resourceType, region, reason string) {
+	current.Lock()
+	defer current.Unlock()
+
+	current.report.SkippedSweepers = append(current.report.SkippedSweepers, SkippedSweeper{
+		ResourceType: resourceType,
+		Region:       region,
+		Reason:       reason,
+	})
+}
+
+// Write writes the report as JSON to the file named by the report environment variable, if set.
+// The whole report is rewritten each time, so that it is complete however the sweeper run ends.
+func Write() error {
+	path := os.Getenv(envvar.SweepReport)
+	if path == "" {
+		return nil
+	}
+
+	current.Lock()
+	defer current.Unlock()
+
+	b, err := json.MarshalIndent(current.report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644)
+}
+
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	resourceTypeKey
+)
+
+// WithRegion returns a new context with the sweeper's Region.
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey, region)
+}
+
+// RegionFromContext returns the sweeper's Region, or "" if it is not known.
+func RegionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey).(string)
+	return v
+}
+
+// WithResourceType returns a new context with the sweeper's resource type.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeKey, resourceType)
+}
+
+// ResourceTypeFromContext returns the sweeper's resource type, or "" if it is not known.
+func ResourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeKey).(string)
+	return v
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+)
+
+const (
+	dryRunMiddlewareID = "TF_AWS_SweepDryRun"
+)
+
+// readOnlyOperationPrefixes are the prefixes of the names of API operations that are allowed in dry-run mode.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// DryRun returns whether sweepers are running in dry-run mode.
+// In dry-run mode, SweepOrchestrator reports the resources that would be swept instead of deleting them.
+// SweeperFns that do more than list resources should check DryRun.
+func DryRun() bool {
+	return report.DryRun()
+}
+
+// Skip records that a sweeper chose not to sweep a resource.
+func Skip(ctx context.Context, id, reason string) {
+	tflog.Info(ctx, "Skipping resource", map[string]any{
+		"id":     id,
+		"reason": reason,
+	})
+
+	report.AddSkipped(report.Resource{
+		ResourceType: report.ResourceTypeFromContext(ctx),
+		ID:           id,
+		Region:       report.RegionFromContext(ctx),
+	}, reason)
+}
+
+// SkipSweeper records that a sweeper was skipped, for example because awsv2.SkipSweepError matched a listing error.
+// awsv2.Register calls SkipSweeper; sweepers added with resource.AddTestSweepers handle awsv2.SkipSweepError themselves
+// and aren't recorded unless they call it.
+func SkipSweeper(ctx context.Context, err error) {
+	tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+		"error": err.Error(),
+	})
+
+	report.AddSkippedSweeper(report.ResourceTypeFromContext(ctx), report.RegionFromContext(ctx), err.Error())
+	writeReport(ctx)
+}
+
+// describe returns the report entry for the specified Sweepable.
+func describe(ctx context.Context, sweepable Sweepable) report.Resource {
+	var r report.Resource
+
+	if v, ok := sweepable.(report.Describer); ok {
+		r = v.Describe(ctx)
+	} else {
+		r.Sweepable = fmt.Sprintf("%T", sweepable)
+	}
+
+	if r.ResourceType == "" {
+		r.ResourceType = report.ResourceTypeFromContext(ctx)
+	}
+	if r.Region == "" {
+		r.Region = report.RegionFromContext(ctx)
+	}
+
+	return r
+}
+
+func writeReport(ctx context.Context) {
+	if err := report.Write(); err != nil {
+		tflog.Error(ctx, "Writing sweeper report", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// addDryRunMiddleware prevents API clients from calling API operations that aren't read-only.
+// This stops sweepers that delete resources directly, without a Sweepable, from doing so in dry-run mode.
+func addDryRunMiddleware(stack *middleware.Stack) error {
+	// Run after the operation's service metadata has been registered.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(dryRunMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if operationName := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(operationName) {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("sweeper dry run: not calling %s %s", awsmiddleware.GetServiceID(ctx), operationName)
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+func isReadOnlyOperation(operationName string) bool {
+	return slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operationName, prefix)
+	})
+}
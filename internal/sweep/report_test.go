@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testDeleteSweepable struct {
+	t  *testing.T
+	id string
+}
+
+func (s testDeleteSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	s.t.Errorf("Delete called for %s in dry-run mode", s.id)
+	return nil
+}
+
+func (s testDeleteSweepable) Describe(ctx context.Context) report.Resource {
+	return report.Resource{
+		ID: s.id,
+	}
+}
+
+func TestSweepOrchestrator_dryRun(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepReport, reportPath)
+
+	ctx := report.WithRegion(t.Context(), "us-west-2") //lintignore:AWSAT003
+	sweepables := []Sweepable{
+		WithResourceType("aws_vpc", testDeleteSweepable{t: t, id: "vpc-1"}),
+		WithResourceType("aws_subnet", testDeleteSweepable{t: t, id: "subnet-1"}),
+	}
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("reading report: %s", err)
+	}
+
+	var got report.Report
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("parsing report: %s", err)
+	}
+
+	for _, want := range []report.Resource{
+		{ResourceType: "aws_vpc", ID: "vpc-1", Region: "us-west-2"},       //lintignore:AWSAT003
+		{ResourceType: "aws_subnet", ID: "subnet-1", Region: "us-west-2"}, //lintignore:AWSAT003
+	} {
+		if !slices.ContainsFunc(got.Candidates, func(r report.Resource) bool {
+			return r.ResourceType == want.ResourceType && r.ID == want.ID && r.Region == want.Region
+		}) {
+			t.Errorf("report candidates %v do not contain %v", got.Candidates, want)
+		}
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeVpcs":             true,
+		"GetRole":                  true,
+		"ListBuckets":              true,
+		"BatchGetItem":             true,
+		"DeleteVpc":                false,
+		"TerminateInstances":       false,
+		"DisassociateRouteTable":   false,
+		"PutBucketPolicy":          false,
+		"UpdateServiceSettings":    false,
+		"ChangeResourceRecordSets": false,
+		"":                         false,
+	}
+
+	for operationName, want := range testCases {
+		t.Run(operationName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isReadOnlyOperation(operationName); got != want {
+				t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operationName, got, want)
+			}
+		})
+	}
+}
@@ -5,13 +5,17 @@ package sdk
 
 import (
 	"context"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +43,69 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// ARN returns the resource's ARN, if known.
+func (sr *sweepResource) ARN() string {
+	if _, ok := sr.resource.SchemaMap()[names.AttrARN]; ok {
+		if v, ok := sr.d.Get(names.AttrARN).(string); ok && v != "" {
+			return v
+		}
+	}
+
+	if id := sr.d.Id(); arn.IsARN(id) {
+		return id
+	}
+
+	return ""
+}
+
+// creationTimeAttributes are the names of attributes that commonly hold a resource's creation time in RFC 3339 format.
+var creationTimeAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreateTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// CreationTime returns the resource's creation time, if known.
+func (sr *sweepResource) CreationTime() time.Time {
+	schemaMap := sr.resource.SchemaMap()
+
+	for _, attr := range creationTimeAttributes {
+		if _, ok := schemaMap[attr]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(attr).(string); ok && v != "" {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return t
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+// ReadMetadata reads the resource, so that its ARN and creation time are known.
+// It returns false if the resource no longer exists.
+func (sr *sweepResource) ReadMetadata(ctx context.Context) (bool, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return false, err
+	}
+
+	return sr.d.Id() != "", nil
+}
+
+func (sr *sweepResource) Describe(ctx context.Context) report.Resource {
+	return report.Resource{
+		ID:     sr.d.Id(),
+		Region: sr.meta.Region(ctx),
+	}
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -22,7 +25,10 @@ const (
 	ResourcePrefix = "tf-acc-test"
 )
 
-const defaultSweeperAssumeRoleDurationSeconds = 3600
+const (
+	defaultSweeperAssumeRoleDurationSeconds = 3600
+	defaultSweepParallelism                 = 10
+)
 
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
@@ -63,6 +69,12 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	dryRun := DryRun()
+	if dryRun {
+		conf.APIOptions = append(conf.APIOptions, addDryRunMiddleware)
+	}
+	report.SetDryRun(dryRun)
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -104,20 +116,107 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator sweeps the specified resources.
+// Resources of different types (see WithResourceType) are swept in dependency order (see RegisterResourceTypeDependencies),
+// one wave at a time. Within a wave, resources are swept concurrently, up to the configured parallelism.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
-	var g multierror.Group
+	sweepables, err := filterSweepables(ctx, sweepables)
+	if err != nil {
+		return fmt.Errorf("filtering resources to sweep: %w", err)
+	}
 
-	for _, sweepable := range sweepables {
-		g.Go(func() error {
-			return sweepable.Delete(ctx, optFns...)
+	waves, err := sweepWaves(sweepables, registry.resourceTypeDependencies())
+	if err != nil {
+		return fmt.Errorf("ordering resources to sweep: %w", err)
+	}
+
+	dryRun := DryRun()
+	parallelism := sweepParallelism(ctx)
+	var errs *multierror.Error
+
+	for i, wave := range waves {
+		if len(waves) > 1 {
+			tflog.Info(ctx, "Sweeping resources in dependency order", map[string]any{
+				"sweep_wave":           i + 1,
+				"sweep_waves":          len(waves),
+				"sweep_resources":      len(wave),
+				"sweep_parallelism":    parallelism,
+				"sweep_resource_types": resourceTypes(wave),
+			})
+		}
+
+		semaphore := tfsync.NewSemaphore(parallelism)
+		var g multierror.Group
+
+		for _, sweepable := range wave {
+			semaphore.Wait()
+			g.Go(func() error {
+				defer semaphore.Notify()
+
+				r := describe(ctx, sweepable)
+				if dryRun {
+					tflog.Info(ctx, "Dry run, not sweeping resource", map[string]any{
+						"resource_type": r.ResourceType,
+						"id":            r.ID,
+						"region":        r.Region,
+					})
+					report.AddCandidate(r)
+
+					return nil
+				}
+
+				err := sweepable.Delete(ctx, optFns...)
+				if err != nil {
+					r.Error = err.Error()
+				}
+				report.AddCandidate(r)
+
+				return err
+			})
+		}
+
+		// Sweep later waves even if there were errors; it's best effort.
+		errs = multierror.Append(errs, g.Wait())
+	}
+
+	writeReport(ctx)
+
+	return errs.ErrorOrNil()
+}
+
+// sweepParallelism returns the maximum number of resources to sweep concurrently.
+func sweepParallelism(ctx context.Context) int {
+	v := os.Getenv(envvar.SweepParallelism)
+	if v == "" {
+		return defaultSweepParallelism
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		tflog.Warn(ctx, "Invalid sweep parallelism, using default", map[string]any{
+			envvar.SweepParallelism: v,
+			"default":               defaultSweepParallelism,
 		})
+		return defaultSweepParallelism
+	}
+
+	return n
+}
+
+func resourceTypes(sweepables []Sweepable) []string {
+	var resourceTypes []string
+
+	for _, sweepable := range sweepables {
+		if v := ResourceTypeOf(sweepable); v != "" && !slices.Contains(resourceTypes, v) {
+			resourceTypes = append(resourceTypes, v)
+		}
 	}
 
-	return g.Wait().ErrorOrNil()
+	return resourceTypes
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
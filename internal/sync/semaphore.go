@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+)
+
+// Semaphore can be used to limit concurrent executions.
+// This can be used to work with resources or APIs with low quotas.
+type Semaphore chan struct{}
+
+// NewSemaphore returns a new semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
+// Acquire waits for capacity in the semaphore, or for the context to be done.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release releases capacity acquired with Acquire.
+func (s Semaphore) Release() {
+	s.Notify()
+}
+
+// Wait waits for capacity in the semaphore.
+func (s Semaphore) Wait() {
+	s <- struct{}{}
+}
+
+// Notify releases capacity acquired with Wait.
+func (s Semaphore) Notify() {
+	// Make the Notify non-blocking. This can happen if a Wait was never issued
+	select {
+	case <-s:
+	default:
+	}
+}
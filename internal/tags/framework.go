@@ -5,6 +5,7 @@ package tags
 
 import (
 	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -35,6 +36,17 @@ func TagsAttributeRequired() schema.Attribute {
 	}
 }
 
+// ListResourceTagsAttribute returns the list resource configuration attribute used to
+// restrict results to resources having all of the specified tags.
+func ListResourceTagsAttribute() listschema.MapAttribute {
+	return listschema.MapAttribute{
+		CustomType:  MapType,
+		Description: "Only list resources that have all of the specified tags.",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
 var (
 	Unknown = types.MapUnknown(types.StringType)
 )
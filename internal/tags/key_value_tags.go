@@ -53,6 +53,12 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path to a local tag policy document
+	//
+	// When set, tag policy compliance is checked against this document instead of the
+	// effective tag policy retrieved from AWS Organizations.
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
@@ -75,9 +81,32 @@ type TagPolicyConfig struct {
 	// shared across both Plugin SDK V2 and Plugin Framework based resources.
 	Severity string
 
+	// PolicyFile is the path to a local tag policy document
+	//
+	// When set, RequiredTags and TagRules are read from this document, using the
+	// same syntax as AWS Organizations tag policies, instead of from AWS.
+	PolicyFile string
+
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// TagRules is a mapping of Terraform resource type names to the tag key
+	// capitalization and allowed values enforced by the effective tag policy
+	TagRules map[string][]TagRule
+}
+
+// TagRule contains the compliance rules for a single tag key in a tag policy.
+type TagRule struct {
+	// Key is the tag key with the capitalization required by the policy.
+	//
+	// Tag keys are matched case-insensitively, so a tag whose key differs from
+	// Key only in capitalization is noncompliant.
+	Key string
+
+	// Values are the allowed tag values. A value ending in "*" matches any
+	// value with the preceding prefix. An empty slice allows any value.
+	Values []string
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -431,6 +460,44 @@ func (tags KeyValueTags) ContainsAllKeys(target KeyValueTags) bool {
 	return true
 }
 
+// NoncompliantTags returns a description of each tag that does not comply with
+// the given tag policy rules, sorted by tag key.
+// Tags without a matching rule are compliant.
+func (tags KeyValueTags) NoncompliantTags(rules []TagRule) []string {
+	var result []string
+
+	for _, rule := range rules {
+		for k, v := range tags {
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+
+			if k != rule.Key {
+				result = append(result, fmt.Sprintf("%s: key must be capitalized as %q", k, rule.Key))
+			}
+
+			if len(rule.Values) == 0 {
+				continue
+			}
+
+			value := v.ValueString()
+			allowed := slices.ContainsFunc(rule.Values, func(pattern string) bool {
+				if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+					return strings.HasPrefix(value, prefix)
+				}
+				return value == pattern
+			})
+			if !allowed {
+				result = append(result, fmt.Sprintf("%s: value %q is not one of %q", k, value, rule.Values))
+			}
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
 func (tags KeyValueTags) Difference(target KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
 
@@ -2037,6 +2037,83 @@ func TestKeyValueTagsContainsAllKeys(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsNoncompliantTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		source KeyValueTags
+		rules  []TagRule
+		want   []string
+	}{
+		{
+			name:   "no_rules",
+			source: New(ctx, map[string]string{"key1": "value1"}),
+			want:   nil,
+		},
+		{
+			name:   "no_matching_tag",
+			source: New(ctx, map[string]string{"key1": "value1"}),
+			rules: []TagRule{
+				{Key: "CostCenter", Values: []string{"100"}},
+			},
+			want: nil,
+		},
+		{
+			name:   "compliant",
+			source: New(ctx, map[string]string{"CostCenter": "100", "Owner": "team-a"}),
+			rules: []TagRule{
+				{Key: "CostCenter", Values: []string{"100", "200"}},
+				{Key: "Owner"},
+			},
+			want: nil,
+		},
+		{
+			name:   "wildcard",
+			source: New(ctx, map[string]string{"CostCenter": "300-east"}),
+			rules: []TagRule{
+				{Key: "CostCenter", Values: []string{"100", "300*"}},
+			},
+			want: nil,
+		},
+		{
+			name:   "key_capitalization",
+			source: New(ctx, map[string]string{"costcenter": "100"}),
+			rules: []TagRule{
+				{Key: "CostCenter", Values: []string{"100"}},
+			},
+			want: []string{
+				`costcenter: key must be capitalized as "CostCenter"`,
+			},
+		},
+		{
+			name:   "value_not_allowed",
+			source: New(ctx, map[string]string{"CostCenter": "400", "owner": "team-a"}),
+			rules: []TagRule{
+				{Key: "CostCenter", Values: []string{"100", "300*"}},
+				{Key: "Owner"},
+			},
+			want: []string{
+				`CostCenter: value "400" is not one of ["100" "300*"]`,
+				`owner: key must be capitalized as "Owner"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.source.NoncompliantTags(testCase.rules)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("unexpected NoncompliantTags: %q", got)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsEqual(t *testing.T) {
 	t.Parallel()
 
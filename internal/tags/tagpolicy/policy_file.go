@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ReadPolicyFile reads a local tag policy document, using the same syntax as
+// AWS Organizations tag policies, and returns the required tags and tag rules
+// for each Terraform resource type
+//
+// This allows tag policy compliance to be checked without access to the
+// Organizations or Resource Groups Tagging APIs.
+func ReadPolicyFile(ctx context.Context, path string) (map[string]tftags.KeyValueTags, map[string][]tftags.TagRule, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading tag policy file: %w", err)
+	}
+
+	doc, err := parsePolicyDocument(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return doc.requiredTags(ctx), doc.tagRules(), nil
+}
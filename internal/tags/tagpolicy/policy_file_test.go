@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestReadPolicyFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "tag-policy.json")
+	content := `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["acm:certificate"]},
+      "report_required_tag_for": {"@@assign": ["acm:certificate", "appmesh:mesh"]}
+    },
+    "owner": {
+      "tag_key": {"@@assign": "Owner"},
+      "report_required_tag_for": {"@@assign": ["acm:certificate"]}
+    }
+  }
+}`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	reqTags, tagRules, err := ReadPolicyFile(ctx, path)
+	if err != nil {
+		t.Fatalf("ReadPolicyFile() unexpected error: %s", err)
+	}
+
+	wantReqTags := map[string][]string{
+		"aws_acm_certificate": {"CostCenter", "Owner"},
+		"aws_appmesh_mesh":    {"CostCenter"},
+	}
+	gotReqTags := make(map[string][]string, len(reqTags))
+	for k, v := range reqTags {
+		gotReqTags[k] = v.Keys()
+	}
+	if diff := cmp.Diff(gotReqTags, wantReqTags, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("unexpected required tags diff (+want, -got): %s", diff)
+	}
+
+	wantTagRules := map[string][]tftags.TagRule{
+		"aws_acm_certificate": {{Key: "CostCenter", Values: []string{"100", "200*"}}},
+	}
+	if diff := cmp.Diff(tagRules, wantTagRules); diff != "" {
+		t.Errorf("unexpected tag rules diff (+want, -got): %s", diff)
+	}
+}
+
+func TestReadPolicyFile_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "missing.json")
+
+	if _, _, err := ReadPolicyFile(ctx, path); err == nil {
+		t.Fatal("ReadPolicyFile() expected error")
+	}
+}
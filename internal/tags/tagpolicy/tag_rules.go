@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// allSupported is the enforced_for resource type suffix which applies a tag
+// policy to every supported resource type of a service, e.g. "ec2:ALL_SUPPORTED"
+const allSupported = "ALL_SUPPORTED"
+
+// GetTagRules retrieves the effective tag policy for the calling account and
+// returns the tag rules enforced for each Terraform resource type
+//
+// An account without an effective tag policy has no tag rules.
+func GetTagRules(ctx context.Context, awsConfig aws.Config) (map[string][]tftags.TagRule, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	input := organizations.DescribeEffectivePolicyInput{
+		PolicyType: types.EffectivePolicyTypeTagPolicy,
+	}
+	output, err := client.DescribeEffectivePolicy(ctx, &input)
+
+	if errs.IsA[*types.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return parseTagRules([]byte(aws.ToString(output.EffectivePolicy.PolicyContent)))
+}
+
+// IsAccessDenied returns whether an error from GetTagRules indicates that the
+// calling principal is not allowed to describe the effective tag policy
+func IsAccessDenied(err error) bool {
+	return errs.IsA[*types.AccessDeniedException](err)
+}
+
+// policyDocument is the tag policy syntax shared by tag policies and
+// effective tag policies
+type policyDocument struct {
+	Tags map[string]policyTag `json:"tags"`
+}
+
+type policyTag struct {
+	TagKey               policyValue[string]   `json:"tag_key"`
+	TagValue             policyValue[[]string] `json:"tag_value"`
+	EnforcedFor          policyValue[[]string] `json:"enforced_for"`
+	ReportRequiredTagFor policyValue[[]string] `json:"report_required_tag_for"`
+}
+
+// policyValue is a tag policy element value
+//
+// Tag policies wrap values in an inheritance operator, e.g.
+// `{"@@assign": "CostCenter"}`, whereas effective tag policies
+// contain the resolved value only.
+type policyValue[T any] struct {
+	Value T
+}
+
+func (v *policyValue[T]) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var operators map[string]json.RawMessage
+		if err := json.Unmarshal(data, &operators); err != nil {
+			return err
+		}
+
+		data = operators["@@assign"]
+		if data == nil {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, &v.Value)
+}
+
+// parseTagRules translates a tag policy document into a map of tag rules per
+// Terraform resource type
+func parseTagRules(content []byte) (map[string][]tftags.TagRule, error) {
+	doc, err := parsePolicyDocument(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.tagRules(), nil
+}
+
+func parsePolicyDocument(content []byte) (*policyDocument, error) {
+	var doc policyDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	return &doc, nil
+}
+
+// tagRules returns the tag rules enforced for each Terraform resource type
+func (doc *policyDocument) tagRules() map[string][]tftags.TagRule {
+	m := make(map[string][]tftags.TagRule)
+	for _, name := range slices.Sorted(maps.Keys(doc.Tags)) {
+		tag := doc.Tags[name]
+
+		rule := tftags.TagRule{
+			Key:    tag.key(name),
+			Values: tag.TagValue.Value,
+		}
+
+		for _, tfType := range lookupResourceTypes(tag.EnforcedFor.Value) {
+			m[tfType] = append(m[tfType], rule)
+		}
+	}
+
+	return m
+}
+
+// requiredTags returns the tags required for each Terraform resource type
+func (doc *policyDocument) requiredTags(ctx context.Context) map[string]tftags.KeyValueTags {
+	m := make(map[string]tftags.KeyValueTags)
+	for name, tag := range doc.Tags {
+		newTags := tftags.New(ctx, []string{tag.key(name)})
+
+		for _, tfType := range lookupResourceTypes(tag.ReportRequiredTagFor.Value) {
+			if v, ok := m[tfType]; ok {
+				m[tfType] = v.Merge(newTags)
+			} else {
+				m[tfType] = newTags
+			}
+		}
+	}
+
+	return m
+}
+
+// key returns the tag key with the capitalization required by the policy
+func (tag policyTag) key(name string) string {
+	if tag.TagKey.Value != "" {
+		return tag.TagKey.Value
+	}
+
+	return name
+}
+
+// lookupResourceTypes translates Tagris resource type names, including
+// "<service>:ALL_SUPPORTED" wildcards, into Terraform resource type names
+func lookupResourceTypes(resourceTypes []string) []string {
+	var result []string
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupported); ok {
+			for k, v := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					result = append(result, v)
+				}
+			}
+			continue
+		}
+
+		if v, ok := Lookup[resourceType]; ok {
+			result = append(result, v)
+		}
+	}
+
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParseTagRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+		want    map[string][]tftags.TagRule
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			content: `{}`,
+			want:    map[string][]tftags.TagRule{},
+		},
+		{
+			name:    "invalid",
+			content: `{"tags":`,
+			wantErr: true,
+		},
+		{
+			name: "policy",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["acm:certificate", "appmesh:mesh"]}
+    },
+    "owner": {
+      "tag_key": {"@@assign": "Owner"}
+    }
+  }
+}`,
+			want: map[string][]tftags.TagRule{
+				"aws_acm_certificate": {{Key: "CostCenter", Values: []string{"100", "200*"}}},
+				"aws_appmesh_mesh":    {{Key: "CostCenter", Values: []string{"100", "200*"}}},
+			},
+		},
+		{
+			name: "effective policy",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "enforced_for": ["acm:certificate"]
+    },
+    "project": {
+      "tag_value": ["alpha"],
+      "enforced_for": ["acm:certificate", "unknown:resource"]
+    }
+  }
+}`,
+			want: map[string][]tftags.TagRule{
+				"aws_acm_certificate": {
+					{Key: "CostCenter"},
+					{Key: "project", Values: []string{"alpha"}},
+				},
+			},
+		},
+		{
+			name: "all supported",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "enforced_for": {"@@assign": ["apprunner:ALL_SUPPORTED", "apprunner:service"]}
+    }
+  }
+}`,
+			want: map[string][]tftags.TagRule{
+				"aws_apprunner_auto_scaling_configuration_version": {{Key: "CostCenter"}},
+				"aws_apprunner_observability_configuration":        {{Key: "CostCenter"}},
+				"aws_apprunner_service":                            {{Key: "CostCenter"}},
+				"aws_apprunner_vpc_connector":                      {{Key: "CostCenter"}},
+				"aws_apprunner_vpc_ingress_connection":             {{Key: "CostCenter"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTagRules([]byte(testCase.content))
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("parseTagRules() err %t, want %t: %s", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessDenied(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "other error",
+			err:  errors.New("test"),
+		},
+		{
+			name: "not found",
+			err:  &types.EffectivePolicyNotFoundException{},
+		},
+		{
+			name: "access denied",
+			err:  &types.AccessDeniedException{},
+			want: true,
+		},
+		{
+			name: "wrapped access denied",
+			err:  fmt.Errorf("describing effective policy: %w", &types.AccessDeniedException{}),
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsAccessDenied(tc.err); got != tc.want {
+				t.Errorf("IsAccessDenied() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
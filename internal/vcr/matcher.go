@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+var (
+	// defaultIgnoredHeaders are request headers which vary between otherwise identical requests
+	//
+	// This includes the SigV4 signing headers, SDK invocation metadata, and headers
+	// derived from the request body.
+	defaultIgnoredHeaders = []string{
+		"Amz-Sdk-Invocation-Id",
+		"Amz-Sdk-Request",
+		"Authorization",
+		"Content-Length",
+		"Content-Md5",
+		"User-Agent",
+		"X-Amz-Checksum-*",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Date",
+		"X-Amz-Sdk-Checksum-Algorithm",
+		"X-Amz-Security-Token",
+		"X-Amz-User-Agent",
+		"X-Amzn-Trace-Id",
+	}
+
+	// defaultIgnoredQueryParameters are the SigV4 query string (presigned URL) parameters
+	defaultIgnoredQueryParameters = []string{
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+		"X-Amz-SignedHeaders",
+	}
+
+	// defaultIgnoredBodyFields are request body fields whose values are generated for each request,
+	// such as idempotency tokens
+	defaultIgnoredBodyFields = []string{
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+		"clientRequestToken",
+		"clientToken",
+		"idempotencyToken",
+	}
+)
+
+type matcher struct {
+	ignoredHeaders         []string
+	ignoredQueryParameters []string
+	ignoredBodyFields      []string
+	redactor               *Redactor
+}
+
+// MatcherOption configures the request matcher returned by NewMatcher
+type MatcherOption func(*matcher)
+
+// WithIgnoredHeaders ignores the named request headers when matching requests
+//
+// Header names are case-insensitive. A name ending in "*" ignores all headers with that prefix.
+func WithIgnoredHeaders(names ...string) MatcherOption {
+	return func(m *matcher) {
+		m.ignoredHeaders = append(m.ignoredHeaders, names...)
+	}
+}
+
+// WithIgnoredQueryParameters ignores the named URL query string parameters when matching requests
+func WithIgnoredQueryParameters(names ...string) MatcherOption {
+	return func(m *matcher) {
+		m.ignoredQueryParameters = append(m.ignoredQueryParameters, names...)
+	}
+}
+
+// WithIgnoredBodyFields ignores the named request body fields when matching requests
+//
+// Fields are ignored at any depth in JSON and XML bodies, and in form encoded (AWS Query protocol)
+// bodies both as top-level and as nested (e.g. "Tags.member.1.Key") parameters.
+func WithIgnoredBodyFields(names ...string) MatcherOption {
+	return func(m *matcher) {
+		m.ignoredBodyFields = append(m.ignoredBodyFields, names...)
+	}
+}
+
+// WithRedactor redacts requests with the Redactor which redacted the recorded interactions before matching them
+//
+// Without it, requests containing redacted values, such as passwords, never match their recorded interactions.
+func WithRedactor(r *Redactor) MatcherOption {
+	return func(m *matcher) {
+		m.redactor = r
+	}
+}
+
+// NewMatcher returns a function which matches HTTP requests to recorded interactions
+//
+// Requests match on method, URL, headers and body. Volatile values, such as SigV4 signing
+// headers and query string parameters, SDK invocation metadata and idempotency tokens, are
+// always ignored. Additional values to ignore can be configured via options.
+// JSON and form encoded bodies are compared semantically, ignoring field order.
+// XML bodies are compared element by element, ignoring insignificant whitespace.
+func NewMatcher(ctx context.Context, optFns ...MatcherOption) recorder.MatcherFunc {
+	m := &matcher{
+		ignoredHeaders:         slices.Clone(defaultIgnoredHeaders),
+		ignoredQueryParameters: slices.Clone(defaultIgnoredQueryParameters),
+		ignoredBodyFields:      slices.Clone(defaultIgnoredBodyFields),
+	}
+	for _, optFn := range optFns {
+		optFn(m)
+	}
+
+	return func(r *http.Request, i cassette.Request) bool {
+		return m.match(ctx, r, i)
+	}
+}
+
+func (m *matcher) match(ctx context.Context, r *http.Request, i cassette.Request) bool {
+	if r.Method != i.Method {
+		return false
+	}
+
+	var body string
+	if r.Body != nil {
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body = b.String()
+	}
+
+	u, header := r.URL, r.Header
+	if m.redactor != nil {
+		// Recorded requests were redacted before being saved, so compare them with the request as it would have been saved.
+		var s string
+		s, header, body = m.redactor.redactRequest(r.URL.String(), r.Header, body)
+
+		var err error
+		if u, err = url.Parse(s); err != nil {
+			tflog.Debug(ctx, "Failed to parse redacted request URL", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+	}
+
+	if !m.urlMatches(ctx, u, i.URL) {
+		return false
+	}
+
+	if !m.headersMatch(header, i.Headers) {
+		return false
+	}
+
+	if r.Body == nil {
+		return true
+	}
+
+	return m.bodyMatches(ctx, header.Get("Content-Type"), body, i.Body)
+}
+
+func (m *matcher) urlMatches(ctx context.Context, u *url.URL, s string) bool {
+	if u.String() == s {
+		return true
+	}
+
+	v, err := url.Parse(s)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette URL", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.EscapedPath() != v.EscapedPath() {
+		return false
+	}
+
+	return reflect.DeepEqual(m.query(u), m.query(v))
+}
+
+func (m *matcher) query(u *url.URL) url.Values {
+	values := u.Query()
+	for _, name := range m.ignoredQueryParameters {
+		values.Del(name)
+	}
+
+	return values
+}
+
+func (m *matcher) headersMatch(h1, h2 http.Header) bool {
+	return maps.EqualFunc(m.headers(h1), m.headers(h2), slices.Equal)
+}
+
+func (m *matcher) headers(h http.Header) http.Header {
+	result := make(http.Header, len(h))
+	for k, v := range h {
+		if !m.isIgnoredHeader(k) {
+			result[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+
+	return result
+}
+
+func (m *matcher) isIgnoredHeader(name string) bool {
+	return slices.ContainsFunc(m.ignoredHeaders, func(ignored string) bool {
+		if prefix, ok := strings.CutSuffix(ignored, "*"); ok {
+			return len(name) >= len(prefix) && strings.EqualFold(name[:len(prefix)], prefix)
+		}
+		return strings.EqualFold(name, ignored)
+	})
+}
+
+func (m *matcher) bodyMatches(ctx context.Context, contentType, body, cassetteBody string) bool {
+	// If body matches identically, we are done.
+	if body == cassetteBody {
+		return true
+	}
+
+	mediaType, _, _ := strings.Cut(contentType, ";")
+
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	switch strings.TrimSpace(mediaType) {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		var requestJSON, cassetteJSON any
+
+		if err := json.Unmarshal([]byte(body), &requestJSON); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := json.Unmarshal([]byte(cassetteBody), &cassetteJSON); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(m.removeJSONFields(requestJSON), m.removeJSONFields(cassetteJSON))
+
+	case "application/x-www-form-urlencoded":
+		// Form parameters might be the same, but reordered. Try parsing and comparing.
+		requestForm, err := url.ParseQuery(body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteForm, err := url.ParseQuery(cassetteBody)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(m.removeFormFields(requestForm), m.removeFormFields(cassetteForm))
+
+	case "application/xml", "text/xml":
+		body, cassetteBody = m.removeXMLFields(body), m.removeXMLFields(cassetteBody)
+		if body == cassetteBody {
+			return true
+		}
+
+		// XML might be the same, but formatted differently. Try parsing and comparing.
+		requestXML, err := xmlTokens(body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteXML, err := xmlTokens(cassetteBody)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXML, cassetteXML)
+	}
+
+	return false
+}
+
+func (m *matcher) removeJSONFields(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if slices.Contains(m.ignoredBodyFields, k) {
+				delete(v, k)
+				continue
+			}
+			v[k] = m.removeJSONFields(e)
+		}
+	case []any:
+		for k, e := range v {
+			v[k] = m.removeJSONFields(e)
+		}
+	}
+
+	return v
+}
+
+func (m *matcher) removeFormFields(values url.Values) url.Values {
+	for k := range values {
+		for _, name := range m.ignoredBodyFields {
+			if k == name || strings.HasSuffix(k, "."+name) {
+				delete(values, k)
+			}
+		}
+	}
+
+	return values
+}
+
+func (m *matcher) removeXMLFields(s string) string {
+	for _, name := range m.ignoredBodyFields {
+		s = xmlElementRegexp(name).ReplaceAllString(s, "")
+	}
+
+	return s
+}
+
+// xmlTokens returns the elements and non-whitespace character data of an XML document
+func xmlTokens(s string) ([]xml.Token, error) {
+	var tokens []xml.Token
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			return tokens, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := token.(type) {
+		case xml.CharData:
+			if len(bytes.TrimSpace(v)) == 0 {
+				continue
+			}
+		case xml.Comment, xml.Directive, xml.ProcInst:
+			continue
+		}
+
+		tokens = append(tokens, xml.CopyToken(token))
+	}
+}
+
+// xmlElementRegexp returns a regular expression matching a simple (text only) XML element
+func xmlElementRegexp(name string) *regexp.Regexp {
+	name = regexp.QuoteMeta(name)
+	return regexp.MustCompile(`<` + name + `(\s[^>]*)?>[^<]*</` + name + `>`)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestNewMatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name        string
+		options     []vcr.MatcherOption
+		contentType string
+		url         string
+		headers     http.Header
+		body        string
+		cassette    cassette.Request
+		want        bool
+	}{
+		{
+			name:     "identical",
+			url:      "https://example.amazonaws.com/",
+			body:     "{}",
+			cassette: cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: "{}"},
+			want:     true,
+		},
+		{
+			name:     "different URL",
+			url:      "https://example.amazonaws.com/a",
+			cassette: cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/b"},
+			want:     false,
+		},
+		{
+			name:     "presigned URL",
+			url:      "https://example.amazonaws.com/?a=1&X-Amz-Date=20250101T000000Z&X-Amz-Signature=abc",
+			cassette: cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/?X-Amz-Signature=def&X-Amz-Date=20250202T000000Z&a=1"},
+			want:     true,
+		},
+		{
+			name: "SigV4 headers",
+			url:  "https://example.amazonaws.com/",
+			headers: http.Header{
+				"Authorization":         {"AWS4-HMAC-SHA256 Signature=abc"},
+				"X-Amz-Date":            {"20250101T000000Z"},
+				"X-Amz-Target":          {"Service.Describe"},
+				"Amz-Sdk-Invocation-Id": {"1"},
+			},
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://example.amazonaws.com/",
+				Headers: http.Header{
+					"X-Amz-Date":            {"20250202T000000Z"},
+					"X-Amz-Target":          {"Service.Describe"},
+					"Amz-Sdk-Invocation-Id": {"2"},
+				},
+			},
+			want: true,
+		},
+		{
+			name:    "different operation",
+			url:     "https://example.amazonaws.com/",
+			headers: http.Header{"X-Amz-Target": {"Service.Describe"}},
+			cassette: cassette.Request{
+				Method:  http.MethodPost,
+				URL:     "https://example.amazonaws.com/",
+				Headers: http.Header{"X-Amz-Target": {"Service.List"}},
+			},
+			want: false,
+		},
+		{
+			name:    "ignored header option",
+			options: []vcr.MatcherOption{vcr.WithIgnoredHeaders("x-custom-*")},
+			url:     "https://example.amazonaws.com/",
+			headers: http.Header{"X-Custom-Id": {"1"}},
+			cassette: cassette.Request{
+				Method:  http.MethodPost,
+				URL:     "https://example.amazonaws.com/",
+				Headers: http.Header{"X-Custom-Id": {"2"}},
+			},
+			want: true,
+		},
+		{
+			name:        "JSON idempotency token",
+			contentType: "application/x-amz-json-1.1",
+			url:         "https://example.amazonaws.com/",
+			body:        `{"Name":"test","ClientToken":"abc","Nested":{"clientToken":"def"}}`,
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: `{"Nested":{"clientToken":"ghi"},"ClientToken":"jkl","Name":"test"}`},
+			want:        true,
+		},
+		{
+			name:        "JSON different value",
+			contentType: "application/x-amz-json-1.1",
+			url:         "https://example.amazonaws.com/",
+			body:        `{"Name":"test1"}`,
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: `{"Name":"test2"}`},
+			want:        false,
+		},
+		{
+			name:        "JSON ignored field option",
+			options:     []vcr.MatcherOption{vcr.WithIgnoredBodyFields("StartTime")},
+			contentType: "application/json",
+			url:         "https://example.amazonaws.com/",
+			body:        `{"Name":"test","StartTime":1700000000}`,
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: `{"Name":"test","StartTime":1800000000}`},
+			want:        true,
+		},
+		{
+			name:        "form idempotency token",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			url:         "https://example.amazonaws.com/",
+			body:        "Action=RunInstances&ClientToken=abc&Version=2016-11-15",
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: "Version=2016-11-15&Action=RunInstances&ClientToken=def"},
+			want:        true,
+		},
+		{
+			name:        "XML idempotency token",
+			contentType: "application/xml",
+			url:         "https://example.amazonaws.com/",
+			body:        "<Create><CallerReference>abc</CallerReference><Name>test</Name></Create>",
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: "<Create><CallerReference>def</CallerReference><Name>test</Name></Create>"},
+			want:        true,
+		},
+		{
+			name:        "XML whitespace",
+			contentType: "application/xml",
+			url:         "https://example.amazonaws.com/",
+			body:        `<?xml version="1.0" encoding="UTF-8"?><Create><Name>test</Name></Create>`,
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: "<Create>\n  <Name>test</Name>\n</Create>"},
+			want:        true,
+		},
+		{
+			name:        "XML different value",
+			contentType: "application/xml",
+			url:         "https://example.amazonaws.com/",
+			body:        "<Create><CallerReference>abc</CallerReference><Name>test1</Name></Create>",
+			cassette:    cassette.Request{Method: http.MethodPost, URL: "https://example.amazonaws.com/", Body: "<Create><CallerReference>def</CallerReference><Name>test2</Name></Create>"},
+			want:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequest(http.MethodPost, testCase.url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range testCase.headers {
+				r.Header[k] = v
+			}
+			if testCase.contentType != "" {
+				r.Header.Set("Content-Type", testCase.contentType)
+				testCase.cassette.Headers = testCase.cassette.Headers.Clone()
+				if testCase.cassette.Headers == nil {
+					testCase.cassette.Headers = make(http.Header)
+				}
+				testCase.cassette.Headers.Set("Content-Type", testCase.contentType)
+			}
+
+			got := vcr.NewMatcher(ctx, testCase.options...)(r, testCase.cassette)
+
+			if got != testCase.want {
+				t.Errorf("unexpected match: %t", got)
+			}
+		})
+	}
+}
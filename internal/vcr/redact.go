@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+const (
+	// redactedValue replaces redacted secrets
+	redactedValue = "REDACTED"
+
+	// redactedAccountIDBase is the first placeholder account ID
+	//
+	// Each distinct account ID is replaced by a distinct placeholder, so that
+	// replayed interactions involving more than one account remain consistent.
+	redactedAccountIDBase = 123456789012
+)
+
+var (
+	// defaultRedactedHeaders are request and response headers removed from recorded interactions
+	defaultRedactedHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// defaultRedactedFields are JSON, XML and form encoded body fields whose values are redacted
+	defaultRedactedFields = []string{
+		"MasterUserPassword",
+		"Password",
+		"SecretAccessKey",
+		"SecretString",
+		"SessionToken",
+		"password",
+		"secretAccessKey",
+		"sessionToken",
+	}
+
+	// arnAccountIDRegexp matches the account ID component of an ARN
+	arnAccountIDRegexp = regexp.MustCompile(`arn:aws[a-z-]*:[a-z0-9-]+:[a-z0-9-]*:([0-9]{12}):`)
+)
+
+// Redactor scrubs account IDs and secrets from recorded interactions before they are saved
+//
+// Account IDs found in ARNs are replaced, wherever they occur, with placeholder account IDs.
+// Sensitive headers are removed, and the values of sensitive body fields are replaced.
+type Redactor struct {
+	headers []string
+	fields  []string
+
+	mu         sync.Mutex
+	values     []string
+	accountIDs map[string]string
+}
+
+// RedactorOption configures the Redactor returned by NewRedactor
+type RedactorOption func(*Redactor)
+
+// WithRedactedHeaders removes the named headers from recorded requests and responses
+func WithRedactedHeaders(names ...string) RedactorOption {
+	return func(r *Redactor) {
+		r.headers = append(r.headers, names...)
+	}
+}
+
+// WithRedactedFields redacts the values of the named fields in recorded request and response bodies
+func WithRedactedFields(names ...string) RedactorOption {
+	return func(r *Redactor) {
+		r.fields = append(r.fields, names...)
+	}
+}
+
+// WithRedactedValues redacts the given values wherever they occur in recorded interactions
+func WithRedactedValues(values ...string) RedactorOption {
+	return func(r *Redactor) {
+		r.values = append(r.values, values...)
+	}
+}
+
+// NewRedactor returns a new Redactor
+//
+// Authorization headers, session tokens and common secret fields are always redacted.
+// Additional values to redact can be configured via options.
+func NewRedactor(optFns ...RedactorOption) *Redactor {
+	r := &Redactor{
+		headers:    slices.Clone(defaultRedactedHeaders),
+		fields:     slices.Clone(defaultRedactedFields),
+		accountIDs: make(map[string]string),
+	}
+	for _, optFn := range optFns {
+		optFn(r)
+	}
+
+	return r
+}
+
+// AddValues redacts the given values wherever they occur in interactions saved after the call,
+// e.g. secrets generated during a test
+func (r *Redactor) AddValues(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.values = append(r.values, values...)
+}
+
+// RecorderOptions returns the hooks which register the Redactor with a VCR recorder
+//
+// Account IDs are discovered as each interaction is captured, and all interactions are
+// redacted immediately before the cassette is saved.
+func (r *Redactor) RecorderOptions() []recorder.Option {
+	return []recorder.Option{
+		recorder.WithHook(r.captureHook, recorder.AfterCaptureHook),
+		recorder.WithHook(r.saveHook, recorder.BeforeSaveHook),
+	}
+}
+
+func (r *Redactor) captureHook(i *cassette.Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range []string{i.Request.URL, i.Request.Body, i.Response.Body} {
+		for _, match := range arnAccountIDRegexp.FindAllStringSubmatch(s, -1) {
+			if accountID := match[1]; r.accountIDs[accountID] == "" {
+				r.accountIDs[accountID] = strconv.Itoa(redactedAccountIDBase + len(r.accountIDs))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Redactor) saveHook(i *cassette.Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	replacer := r.replacer()
+
+	for _, name := range r.headers {
+		i.Request.Headers.Del(name)
+		i.Response.Headers.Del(name)
+	}
+
+	i.Request.URL = replacer.Replace(i.Request.URL)
+	i.Request.Host = replacer.Replace(i.Request.Host)
+	i.Request.RequestURI = replacer.Replace(i.Request.RequestURI)
+	r.redactHeaders(replacer, i.Request.Headers)
+	r.redactHeaders(replacer, i.Response.Headers)
+
+	for k, v := range i.Request.Form {
+		for j := range v {
+			v[j] = replacer.Replace(v[j])
+		}
+		if slices.Contains(r.fields, k) {
+			i.Request.Form[k] = []string{redactedValue}
+		}
+	}
+
+	if body := r.redactBody(replacer, i.Request.Body); body != i.Request.Body {
+		i.Request.Body = body
+		i.Request.ContentLength = int64(len(body))
+		setContentLength(i.Request.Headers, len(body))
+	}
+
+	if body := r.redactBody(replacer, i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+		i.Response.ContentLength = int64(len(body))
+		setContentLength(i.Response.Headers, len(body))
+	}
+
+	return nil
+}
+
+// redactRequest returns a request's URL, headers and body as they would be saved in a cassette
+func (r *Redactor) redactRequest(u string, h http.Header, body string) (string, http.Header, string) {
+	i := cassette.Interaction{
+		Request: cassette.Request{
+			URL:     u,
+			Headers: h.Clone(),
+			Body:    body,
+		},
+	}
+	r.saveHook(&i) //nolint:errcheck // saveHook never returns an error
+
+	return i.Request.URL, i.Request.Headers, i.Request.Body
+}
+
+// replacer returns a strings.Replacer for account IDs and registered values
+func (r *Redactor) replacer() *strings.Replacer {
+	var oldnew []string
+	for _, v := range r.values {
+		if v != "" {
+			oldnew = append(oldnew, v, redactedValue)
+		}
+	}
+	for accountID, placeholder := range r.accountIDs {
+		oldnew = append(oldnew, accountID, placeholder)
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+func (r *Redactor) redactHeaders(replacer *strings.Replacer, h http.Header) {
+	for _, v := range h {
+		for j := range v {
+			v[j] = replacer.Replace(v[j])
+		}
+	}
+}
+
+func (r *Redactor) redactBody(replacer *strings.Replacer, body string) string {
+	body = replacer.Replace(body)
+
+	for _, name := range r.fields {
+		quoted := regexp.QuoteMeta(name)
+
+		// JSON, e.g. "Password":"secret".
+		body = regexp.MustCompile(`("`+quoted+`"\s*:\s*)"(?:[^"\\]|\\.)*"`).ReplaceAllString(body, `${1}"`+redactedValue+`"`)
+		// XML, e.g. <Password>secret</Password>.
+		body = regexp.MustCompile(`(<`+quoted+`(?:\s[^>]*)?>)[^<]*(</`+quoted+`>)`).ReplaceAllString(body, `${1}`+redactedValue+`${2}`)
+		// Form encoded, e.g. Password=secret or Credentials.Password=secret.
+		body = regexp.MustCompile(`((?:^|&)(?:[^&=]*\.)?`+quoted+`=)[^&]*`).ReplaceAllString(body, `${1}`+redactedValue)
+	}
+
+	return body
+}
+
+func setContentLength(h http.Header, n int) {
+	if h.Get("Content-Length") != "" {
+		h.Set("Content-Length", fmt.Sprint(n))
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{"Arn":"arn:aws:iam::111122223333:role/test","Other":"arn:aws:iam::444455556666:role/test","SecretString":"hunter2","Token":"generated-secret"}`)) //nolint:errcheck // test
+	}))
+	t.Cleanup(server.Close)
+
+	cassetteName := filepath.Join(t.TempDir(), "redact")
+	redactor := vcr.NewRedactor(vcr.WithRedactedValues("generated-secret"))
+	opts := append(redactor.RecorderOptions(),
+		recorder.WithMode(recorder.ModeRecordOnly),
+		recorder.WithRealTransport(server.Client().Transport),
+		recorder.WithSkipRequestLatency(true),
+	)
+	rec, err := recorder.New(cassetteName, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"RoleArn":"arn:aws:iam::111122223333:role/test","Password":"hunter2"}`
+	r, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Signature=abc")
+	r.Header.Set("X-Amz-Security-Token", "token")
+
+	resp, err := rec.GetDefaultClient().Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(cassetteName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(c.Interactions), 1; got != want {
+		t.Fatalf("unexpected interactions: %d, want %d", got, want)
+	}
+	i := c.Interactions[0]
+
+	if got, want := i.Request.Body, `{"RoleArn":"arn:aws:iam::123456789012:role/test","Password":"REDACTED"}`; got != want {
+		t.Errorf("unexpected request body: %s, want %s", got, want)
+	}
+	if got, want := i.Response.Body, `{"Arn":"arn:aws:iam::123456789012:role/test","Other":"arn:aws:iam::123456789013:role/test","SecretString":"REDACTED","Token":"REDACTED"}`; got != want {
+		t.Errorf("unexpected response body: %s, want %s", got, want)
+	}
+	for _, name := range []string{"Authorization", "X-Amz-Security-Token"} {
+		if v := i.Request.Headers.Get(name); v != "" {
+			t.Errorf("unexpected %s header: %s", name, v)
+		}
+	}
+}
+
+func TestRedactor_replay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{}`)) //nolint:errcheck // test
+	}))
+	t.Cleanup(server.Close)
+
+	body := `{"Name":"test","MasterUserPassword":"hunter2","Description":"generated-secret"}`
+	newRequest := func(t *testing.T) *http.Request {
+		t.Helper()
+
+		r, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		return r
+	}
+
+	cassetteName := filepath.Join(t.TempDir(), "replay")
+	opts := append(vcr.NewRedactor(vcr.WithRedactedValues("generated-secret")).RecorderOptions(),
+		recorder.WithMode(recorder.ModeRecordOnly),
+		recorder.WithRealTransport(server.Client().Transport),
+		recorder.WithSkipRequestLatency(true),
+	)
+	rec, err := recorder.New(cassetteName, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := rec.GetDefaultClient().Do(newRequest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		options []vcr.MatcherOption
+		wantErr bool
+	}{
+		{
+			name:    "without redactor",
+			wantErr: true,
+		},
+		{
+			name:    "with redactor",
+			options: []vcr.MatcherOption{vcr.WithRedactor(vcr.NewRedactor(vcr.WithRedactedValues("generated-secret")))},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec, err := recorder.New(cassetteName,
+				recorder.WithMatcher(vcr.NewMatcher(ctx, tc.options...)),
+				recorder.WithMode(recorder.ModeReplayOnly),
+				recorder.WithSkipRequestLatency(true),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := rec.GetDefaultClient().Do(newRequest(t))
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			if got := err != nil; got != tc.wantErr {
+				t.Errorf("unexpected error: %v, want error: %t", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/list"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Create scaffolding for a list resource",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return list.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+	listCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	listCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	listCmd.Flags().StringVarP(&name, "name", "n", "", "name of the resource to list")
+	listCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	listCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for a Terraform Plugin SDK V2 resource")
+	listCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and filtering by tags should be generated (Plugin SDK V2 only)")
+}
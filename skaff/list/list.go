@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed listresource.gtpl
+var listResourceTmpl string
+
+//go:embed listresourcesdk.gtpl
+var listResourceSDKTmpl string
+
+//go:embed listresourcetest.gtpl
+var listResourceTestTmpl string
+
+//go:embed testconfig.gtpl
+var testConfigTmpl string
+
+//go:embed testquery.gtpl
+var testQueryTmpl string
+
+//go:embed websitedoc.gtpl
+var websiteTmpl string
+
+type TemplateData struct {
+	Resource             string
+	ResourceAWS          string
+	ResourceLower        string
+	ResourceSnake        string
+	HumanFriendlyService string
+	IncludeComments      bool
+	IncludeTags          bool
+	SDKPackage           string
+	ServicePackage       string
+	Service              string
+	ServiceLower         string
+	AWSServiceName       string
+	PluginFramework      bool
+	HumanResourceName    string
+	ProviderResourceName string
+}
+
+// Create writes scaffolding for a list resource for an existing resource.
+func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceAWS:          capitalizeForAWS(resName),
+		ResourceLower:        convert.ToLowercasePrefix(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      comments,
+		IncludeTags:          tags && !pluginFramework, // Filtering by tags is only generated for Plugin SDKv2 list resources.
+		SDKPackage:           service.GoV2Package(),
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		PluginFramework:      pluginFramework,
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	tmpl := listResourceSDKTmpl
+	if pluginFramework {
+		tmpl = listResourceTmpl
+	}
+	f := fmt.Sprintf("%s_list.go", snakeName)
+	if err = writeTemplate("newlist", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_list_test.go", snakeName)
+	if err = writeTemplate("listtest", tf, listResourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test template: %w", err)
+	}
+
+	testdata := filepath.Join("testdata", resName, "list_basic")
+	if err := os.MkdirAll(testdata, 0755); err != nil {
+		return fmt.Errorf("creating test configuration directory (%s): %w", testdata, err)
+	}
+
+	if err = writeTemplate("testconfig", filepath.Join(testdata, "main.tf"), testConfigTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test configuration template: %w", err)
+	}
+
+	if err = writeTemplate("testquery", filepath.Join(testdata, "main.tfquery.hcl"), testQueryTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test query template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "list-resources", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if _, err := f.Write(buffer.Bytes()); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func capitalizeForAWS(s string) string {
+	return strings.ReplaceAll(s, "VPC", "Vpc")
+}
@@ -162,6 +162,20 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		templateData.IdentityAnnotations = identityAnnotations(m.Resource)
+		templateData.SchemaVersion = m.Resource.SchemaVersion
+
+		stateUpgraders, importFrameworkAttr, err := m.generateStateUpgraders()
+
+		if err != nil {
+			return nil, err
+		}
+
+		templateData.StateUpgraders = stateUpgraders
+		templateData.ImportFrameworkAttr = templateData.ImportFrameworkAttr || importFrameworkAttr
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -181,6 +195,50 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// identityAnnotations returns the Plugin Framework resource identity annotations equivalent to a Plugin SDK resource's identity.
+// The identity's account ID and Region attributes are added by the provider and are not part of the annotations.
+func identityAnnotations(resource *schema.Resource) []string {
+	if resource.Identity == nil || resource.Identity.SchemaFunc == nil {
+		return nil
+	}
+
+	identitySchema := resource.Identity.SchemaFunc()
+
+	var requiredAttributes []string
+	for name, property := range identitySchema {
+		if property.RequiredForImport {
+			requiredAttributes = append(requiredAttributes, name)
+		}
+	}
+	slices.Sort(requiredAttributes)
+
+	_, hasAccountID := identitySchema["account_id"]
+	_, hasRegion := identitySchema["region"]
+
+	var annotations []string
+	if hasAccountID && !hasRegion {
+		annotations = append(annotations, "@Region(global=true)")
+	}
+
+	switch {
+	case len(requiredAttributes) == 0:
+		annotations = append(annotations, "@SingletonIdentity")
+	case len(requiredAttributes) == 1 && !hasAccountID && !hasRegion:
+		// ARN identities only contain the ARN.
+		if v := requiredAttributes[0]; v == "arn" {
+			annotations = append(annotations, "@ArnIdentity")
+		} else {
+			annotations = append(annotations, fmt.Sprintf("@ArnIdentity(%q)", v))
+		}
+	default:
+		for _, v := range requiredAttributes {
+			annotations = append(annotations, fmt.Sprintf("@IdentityAttribute(%q)", v))
+		}
+	}
+
+	return annotations
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
@@ -301,6 +359,10 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
+		} else if name == "arn" && isTopLevelAttribute && !e.IsDataSource && property.Computed && !property.Optional {
+			fprintf(e.SchemaWriter, "framework.ARNAttributeComputedOnly()")
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
@@ -913,11 +975,14 @@ type templateData struct {
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	IdentityAnnotations           []string // e.g. @ArnIdentity
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	SchemaVersion                 int
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+type stateUpgrader struct {
+	Version int    // Prior schema version.
+	Schema  string // Prior schema.
+	Struct  string // Fields of the prior schema's model.
+}
+
+// generateStateUpgraders generates the Plugin Framework prior schemas and models for a Plugin SDK resource's state upgraders.
+// A Plugin SDK state upgrader upgrades state one version at a time, whereas a Plugin Framework state upgrader
+// upgrades state directly to the current version, so a Plugin SDK chain of N upgraders becomes N Plugin Framework upgraders.
+func (m *migrator) generateStateUpgraders() ([]stateUpgrader, bool, error) {
+	upgraders := slices.Clone(m.Resource.StateUpgraders)
+	slices.SortFunc(upgraders, func(a, b schema.StateUpgrader) int {
+		return a.Version - b.Version
+	})
+
+	var importFrameworkAttr bool
+	var stateUpgraders []stateUpgrader
+	for _, upgrader := range upgraders {
+		sbSchema := strings.Builder{}
+		sbStruct := strings.Builder{}
+		emitter := &emitter{
+			Generator:    m.Generator,
+			SchemaWriter: &sbSchema,
+			StructWriter: &sbStruct,
+		}
+
+		if err := emitter.emitSchemaForImpliedType(upgrader.Version, upgrader.Type); err != nil {
+			return nil, false, fmt.Errorf("emitting schema code for state upgrader from version %d: %w", upgrader.Version, err)
+		}
+
+		importFrameworkAttr = importFrameworkAttr || emitter.ImportFrameworkAttr
+		stateUpgraders = append(stateUpgraders, stateUpgrader{
+			Version: upgrader.Version,
+			Schema:  sbSchema.String(),
+			Struct:  sbStruct.String(),
+		})
+	}
+
+	return stateUpgraders, importFrameworkAttr, nil
+}
+
+// emitSchemaForImpliedType generates the Plugin Framework code for the prior schema of a Plugin SDK state upgrader
+// and emits the generated code to the emitter's Writer.
+// A state upgrader only describes the type of the prior state, so all attributes are Computed.
+func (e *emitter) emitSchemaForImpliedType(version int, typ cty.Type) error {
+	if !typ.IsObjectType() {
+		return unsupportedTypeError(nil, typ.FriendlyName())
+	}
+
+	fprintf(e.SchemaWriter, "schema.Schema{\n")
+	fprintf(e.SchemaWriter, "Version:%d,\n", version)
+
+	if err := e.emitImpliedAttributesAndBlocks(nil, typ.AttributeTypes()); err != nil {
+		return err
+	}
+
+	fprintf(e.SchemaWriter, "}")
+
+	return nil
+}
+
+// emitImpliedAttributesAndBlocks generates the Plugin Framework code for the attributes of an object type.
+// Lists and sets of objects are emitted as nested blocks, everything else as attributes.
+func (e *emitter) emitImpliedAttributesAndBlocks(path []string, attributeTypes map[string]cty.Type) error {
+	isTopLevelAttribute := len(path) == 0
+
+	names := make([]string, 0, len(attributeTypes))
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	isBlock := func(typ cty.Type) bool {
+		return (typ.IsListType() || typ.IsSetType()) && typ.ElementType().IsObjectType()
+	}
+
+	emittedFieldName := false
+	for _, name := range names {
+		typ := attributeTypes[name]
+
+		if isBlock(typ) {
+			continue
+		}
+
+		if !emittedFieldName {
+			fprintf(e.SchemaWriter, "Attributes: map[string]schema.Attribute{\n")
+			emittedFieldName = true
+		}
+
+		fprintf(e.SchemaWriter, "%q:", name)
+
+		if isTopLevelAttribute {
+			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
+		}
+
+		if err := e.emitImpliedAttribute(append(path, name), typ); err != nil {
+			return err
+		}
+
+		if isTopLevelAttribute {
+			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+		}
+
+		fprintf(e.SchemaWriter, ",\n")
+	}
+	if emittedFieldName {
+		fprintf(e.SchemaWriter, "},\n")
+	}
+
+	emittedFieldName = false
+	for _, name := range names {
+		typ := attributeTypes[name]
+
+		if !isBlock(typ) {
+			continue
+		}
+
+		if !emittedFieldName {
+			fprintf(e.SchemaWriter, "Blocks: map[string]schema.Block{\n")
+			emittedFieldName = true
+		}
+
+		fprintf(e.SchemaWriter, "%q:", name)
+
+		var blockType, fieldType string
+		if typ.IsListType() {
+			blockType, fieldType = "schema.ListNestedBlock", "types.List"
+		} else {
+			blockType, fieldType = "schema.SetNestedBlock", "types.Set"
+		}
+
+		if isTopLevelAttribute {
+			fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+		}
+
+		fprintf(e.SchemaWriter, "%s{\n", blockType)
+		fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+
+		if err := e.emitImpliedAttributesAndBlocks(append(path, name), typ.ElementType().AttributeTypes()); err != nil {
+			return err
+		}
+
+		fprintf(e.SchemaWriter, "},\n")
+		fprintf(e.SchemaWriter, "}")
+		fprintf(e.SchemaWriter, ",\n")
+	}
+	if emittedFieldName {
+		fprintf(e.SchemaWriter, "},\n")
+	}
+
+	return nil
+}
+
+// emitImpliedAttribute generates the Plugin Framework code for an attribute of a given type
+// and emits the generated code to the emitter's Writer.
+func (e *emitter) emitImpliedAttribute(path []string, typ cty.Type) error {
+	isTopLevelAttribute := len(path) == 1
+
+	var attributeType, fieldType, elementTypeField string
+	switch {
+	case typ == cty.Bool:
+		attributeType, fieldType = "schema.BoolAttribute", "types.Bool"
+	case typ == cty.Number:
+		// The Plugin SDK stores both TypeInt and TypeFloat values as numbers.
+		attributeType, fieldType = "schema.NumberAttribute", "types.Number"
+	case typ == cty.String:
+		attributeType, fieldType = "schema.StringAttribute", "types.String"
+	case typ == cty.DynamicPseudoType:
+		attributeType, fieldType = "schema.DynamicAttribute", "types.Dynamic"
+	case typ.IsListType():
+		attributeType, fieldType, elementTypeField = "schema.ListAttribute", "types.List", "ElementType"
+	case typ.IsMapType():
+		attributeType, fieldType, elementTypeField = "schema.MapAttribute", "types.Map", "ElementType"
+	case typ.IsSetType():
+		attributeType, fieldType, elementTypeField = "schema.SetAttribute", "types.Set", "ElementType"
+	case typ.IsObjectType():
+		attributeType, fieldType, elementTypeField = "schema.ObjectAttribute", "types.Object", "AttributeTypes"
+	default:
+		return unsupportedTypeError(path, typ.FriendlyName())
+	}
+
+	if isTopLevelAttribute {
+		fprintf(e.StructWriter, "%s", fieldType)
+	}
+
+	fprintf(e.SchemaWriter, "%s{\n", attributeType)
+
+	switch elementTypeField {
+	case "ElementType":
+		elementType, err := e.attrType(path, typ.ElementType())
+		if err != nil {
+			return err
+		}
+
+		fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
+	case "AttributeTypes":
+		attributeTypes, err := e.attrTypes(path, typ.AttributeTypes())
+		if err != nil {
+			return err
+		}
+
+		fprintf(e.SchemaWriter, "AttributeTypes:%s,\n", attributeTypes)
+	}
+
+	fprintf(e.SchemaWriter, "Computed:true,\n")
+	fprintf(e.SchemaWriter, "}")
+
+	return nil
+}
+
+// attrType returns the Plugin Framework attr.Type expression for a given type.
+func (e *emitter) attrType(path []string, typ cty.Type) (string, error) {
+	switch {
+	case typ == cty.Bool:
+		return "types.BoolType", nil
+	case typ == cty.Number:
+		return "types.NumberType", nil
+	case typ == cty.String:
+		return "types.StringType", nil
+	case typ == cty.DynamicPseudoType:
+		return "types.DynamicType", nil
+	case typ.IsListType(), typ.IsMapType(), typ.IsSetType():
+		elementType, err := e.attrType(path, typ.ElementType())
+		if err != nil {
+			return "", err
+		}
+
+		var collectionType string
+		switch {
+		case typ.IsListType():
+			collectionType = "types.ListType"
+		case typ.IsMapType():
+			collectionType = "types.MapType"
+		default:
+			collectionType = "types.SetType"
+		}
+
+		return fmt.Sprintf("%s{ElemType:%s}", collectionType, elementType), nil
+	case typ.IsObjectType():
+		attributeTypes, err := e.attrTypes(path, typ.AttributeTypes())
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ObjectType{AttrTypes:%s}", attributeTypes), nil
+	default:
+		return "", unsupportedTypeError(path, typ.FriendlyName())
+	}
+}
+
+// attrTypes returns the Plugin Framework map[string]attr.Type expression for a given object type's attributes.
+func (e *emitter) attrTypes(path []string, attributeTypes map[string]cty.Type) (string, error) {
+	e.ImportFrameworkAttr = true
+
+	names := make([]string, 0, len(attributeTypes))
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	sb := strings.Builder{}
+	sb.WriteString("map[string]attr.Type{\n")
+	for _, name := range names {
+		attributeType, err := e.attrType(append(path, name), attributeTypes[name])
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&sb, "%q:%s,\n", name, attributeType)
+	}
+	sb.WriteString("}")
+
+	return sb.String(), nil
+}